@@ -42,6 +42,8 @@ var AddHistoryEntryCmd = &cli.Command{
 		}
 
 		// Record the history entry using the existing function
+		// The shim records the last-used time itself with a marker file, which also covers runs
+		// without history
 		AddHistoryEntry(version, command, time.Duration(durationMs)*time.Millisecond, exitCode, output, "")
 
 		return nil
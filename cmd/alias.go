@@ -28,7 +28,7 @@ var Alias = &cli.Command{
 					Usage:   "Description to help identify the alias purpose",
 				},
 			},
-			Action: func(c *cli.Context) error {
+			Action: withStateLock(func(c *cli.Context) error {
 				if c.Args().Len() != 2 {
 					return cli.Exit("Usage: jfcm alias set <alias> <version>", 1)
 				}
@@ -52,8 +52,8 @@ var Alias = &cli.Command{
 					return fmt.Errorf("failed to encode alias data: %w", err)
 				}
 
-				return os.WriteFile(filepath.Join(utils.JFCMAliases, alias), data, 0644)
-			},
+				return utils.WriteFileAtomic(filepath.Join(utils.JFCMAliases, alias), data, 0644)
+			}),
 		},
 		{
 			Name:      "get",
@@ -103,12 +103,12 @@ var Alias = &cli.Command{
 			Name:      "remove",
 			Usage:     "Remove an alias",
 			ArgsUsage: "<alias>",
-			Action: func(c *cli.Context) error {
+			Action: withStateLock(func(c *cli.Context) error {
 				if c.Args().Len() != 1 {
 					return cli.Exit("Usage: jfcm alias remove <alias>", 1)
 				}
 				return os.Remove(filepath.Join(utils.JFCMAliases, c.Args().Get(0)))
-			},
+			}),
 		},
 		{
 			Name:  "list",
@@ -140,10 +140,10 @@ func listAliases(noColor bool) error {
 		return fmt.Errorf("failed to read aliases directory: %w", err)
 	}
 
-	// Filter out directories and collect aliases
+	// Filter out directories and in-progress writes, and collect aliases
 	aliases := make(map[string]utils.AliasData)
 	for _, entry := range entries {
-		if !entry.IsDir() {
+		if !entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
 			aliasName := entry.Name()
 			// Read the version and description(if provided) from the alias file
 			aliasData, err := utils.GetAliasData(aliasName)
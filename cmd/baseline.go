@@ -0,0 +1,236 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+)
+
+// baselineNamePattern restricts baseline names to safe file names
+var baselineNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// BenchmarkBaseline is a benchmark run persisted under ~/.jfcm/benchmarks
+type BenchmarkBaseline struct {
+	Name       string           `json:"name"`
+	CreatedAt  time.Time        `json:"created_at"`
+	Command    []string         `json:"command"`
+	Mode       string           `json:"mode"`
+	Iterations int              `json:"iterations"`
+	Warmup     int              `json:"warmup"`
+	Platform   string           `json:"platform"`
+	Results    []BaselineResult `json:"results"`
+}
+
+// BaselineResult holds the samples of one version so later runs can be tested against them
+type BaselineResult struct {
+	Version        string    `json:"version"`
+	DurationsMs    []float64 `json:"durations_ms"`
+	AverageTimeMs  float64   `json:"average_time_ms"`
+	MedianTimeMs   float64   `json:"median_time_ms"`
+	P95TimeMs      float64   `json:"p95_time_ms"`
+	SuccessRate    float64   `json:"success_rate"`
+	AvgMaxRSSBytes int64     `json:"avg_max_rss_bytes"`
+}
+
+// BaselineComparison is the outcome of comparing one version against a stored baseline
+type BaselineComparison struct {
+	Version         string
+	BaselineVersion string
+	BaselineMedian  time.Duration
+	Median          time.Duration
+	ChangePercent   float64
+	Significance    SignificanceResult
+	// Success rates in percent; a drop fails the comparison whatever the timings
+	BaselineSuccessRate float64
+	SuccessRate         float64
+	Regressed           bool
+	Reason              string
+}
+
+// validateBaselineName rejects names that cannot be used as a file name
+func validateBaselineName(name string) error {
+	if !baselineNamePattern.MatchString(name) {
+		return fmt.Errorf("❌ invalid baseline name '%s': use letters, digits, '.', '_' and '-'", name)
+	}
+	return nil
+}
+
+// parseRegressionThreshold parses a threshold such as "10%" or "7.5" into percent
+func parseRegressionThreshold(value string) (float64, error) {
+	trimmed := strings.TrimSuffix(strings.TrimSpace(value), "%")
+	threshold, err := strconv.ParseFloat(trimmed, 64)
+	if err != nil || threshold < 0 {
+		return 0, fmt.Errorf("❌ invalid --max-regression '%s': expected a non-negative percentage such as 10%%", value)
+	}
+	return threshold, nil
+}
+
+// baselinePath returns the file a named baseline is stored in
+func baselinePath(name string) string {
+	return filepath.Join(utils.JFCMBenchmarks, name+".json")
+}
+
+// newBenchmarkBaseline captures the results of a run as a baseline
+func newBenchmarkBaseline(name string, jfCommand []string, config BenchmarkConfig, results []BenchmarkResult) *BenchmarkBaseline {
+	baseline := &BenchmarkBaseline{
+		Name:       name,
+		CreatedAt:  time.Now().UTC(),
+		Command:    jfCommand,
+		Mode:       config.Mode,
+		Iterations: config.Iterations,
+		Warmup:     config.Warmup,
+		Platform:   runtime.GOOS + "/" + runtime.GOARCH,
+	}
+	for _, result := range results {
+		baseline.Results = append(baseline.Results, BaselineResult{
+			Version:        result.Version,
+			DurationsMs:    durationsToMillis(executionDurations(result)),
+			AverageTimeMs:  durationMs(result.AverageTime),
+			MedianTimeMs:   durationMs(result.Stats.Median),
+			P95TimeMs:      durationMs(result.Stats.P95),
+			SuccessRate:    roundTo2(result.SuccessRate),
+			AvgMaxRSSBytes: result.Resources.AvgMaxRSS,
+		})
+	}
+	return baseline
+}
+
+// saveBaseline writes a baseline atomically, replacing any baseline with the same name
+func saveBaseline(baseline *BenchmarkBaseline) error {
+	if err := os.MkdirAll(utils.JFCMBenchmarks, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", utils.JFCMBenchmarks, err)
+	}
+	data, err := json.MarshalIndent(baseline, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode baseline: %w", err)
+	}
+
+	if err := utils.WriteFileAtomic(baselinePath(baseline.Name), data, 0644); err != nil {
+		return fmt.Errorf("failed to save baseline: %w", err)
+	}
+	return nil
+}
+
+// loadBaseline reads a named baseline
+func loadBaseline(name string) (*BenchmarkBaseline, error) {
+	data, err := os.ReadFile(baselinePath(name))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("❌ baseline '%s' not found; create it with --save-baseline %s", name, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline '%s': %w", name, err)
+	}
+	var baseline BenchmarkBaseline
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline '%s': %w", name, err)
+	}
+	return &baseline, nil
+}
+
+// baselineResultFor returns the baseline entry a version is compared against: the entry of the
+// same version or, when the baseline holds a single version, that one (e.g. before an upgrade)
+func baselineResultFor(baseline *BenchmarkBaseline, version string) (BaselineResult, bool) {
+	for _, result := range baseline.Results {
+		if result.Version == version {
+			return result, true
+		}
+	}
+	if len(baseline.Results) == 1 {
+		return baseline.Results[0], true
+	}
+	return BaselineResult{}, false
+}
+
+// compareWithBaseline compares the median time of every result against the baseline and flags
+// the versions that are more than maxRegression percent slower
+func compareWithBaseline(baseline *BenchmarkBaseline, results []BenchmarkResult, maxRegression float64) ([]BaselineComparison, error) {
+	var comparisons []BaselineComparison
+	for _, result := range results {
+		reference, ok := baselineResultFor(baseline, result.Version)
+		if !ok {
+			return nil, fmt.Errorf("❌ baseline '%s' has no result for version %s", baseline.Name, result.Version)
+		}
+
+		baselineMedian := millisToDuration(reference.MedianTimeMs)
+		baselineDurations := make([]time.Duration, len(reference.DurationsMs))
+		for i, ms := range reference.DurationsMs {
+			baselineDurations[i] = millisToDuration(ms)
+		}
+
+		change := percentChange(float64(baselineMedian), float64(result.Stats.Median))
+		comparison := BaselineComparison{
+			Version:             result.Version,
+			BaselineVersion:     reference.Version,
+			BaselineMedian:      baselineMedian,
+			Median:              result.Stats.Median,
+			ChangePercent:       change,
+			Significance:        welchTTest(baselineDurations, executionDurations(result)),
+			BaselineSuccessRate: reference.SuccessRate,
+			SuccessRate:         roundTo2(result.SuccessRate),
+		}
+		// Failing runs are often fast: check they succeed before looking at the timings
+		switch {
+		case comparison.SuccessRate == 0:
+			comparison.Regressed, comparison.Reason = true, "no successful runs"
+		case comparison.SuccessRate < comparison.BaselineSuccessRate:
+			comparison.Regressed = true
+			comparison.Reason = fmt.Sprintf("success rate %.1f%% < %.1f%%", comparison.SuccessRate, comparison.BaselineSuccessRate)
+		case change > maxRegression:
+			comparison.Regressed, comparison.Reason = true, fmt.Sprintf("regression (> %.1f%%)", maxRegression)
+		}
+		comparisons = append(comparisons, comparison)
+	}
+	return comparisons, nil
+}
+
+// countRegressions returns the number of comparisons over the threshold
+func countRegressions(comparisons []BaselineComparison) int {
+	count := 0
+	for _, comparison := range comparisons {
+		if comparison.Regressed {
+			count++
+		}
+	}
+	return count
+}
+
+// displayBaselineComparison prints the comparison against a baseline
+func displayBaselineComparison(w io.Writer, baseline *BenchmarkBaseline, comparisons []BaselineComparison, maxRegression float64, noColor bool) {
+	colors := NewColorScheme(noColor)
+
+	fmt.Fprintf(w, "\n📏 Baseline '%s' (%s, jf %s)\n", baseline.Name,
+		baseline.CreatedAt.Local().Format("2006-01-02 15:04"), strings.Join(baseline.Command, " "))
+	for _, comparison := range comparisons {
+		label := comparison.Version
+		if comparison.BaselineVersion != comparison.Version {
+			label = fmt.Sprintf("%s vs %s", comparison.Version, comparison.BaselineVersion)
+		}
+
+		status := colors.Green.Sprint("✅ ok")
+		if comparison.Regressed {
+			status = colors.Red.Sprintf("❌ %s", comparison.Reason)
+		}
+
+		significance := ""
+		if comparison.Significance.Valid {
+			significance = fmt.Sprintf(", p=%.3f", comparison.Significance.PValue)
+		}
+
+		fmt.Fprintf(w, "  %-24s median %s → %s (%+.1f%%%s)  %s\n",
+			label,
+			formatDuration(comparison.BaselineMedian),
+			formatDuration(comparison.Median),
+			comparison.ChangePercent,
+			significance,
+			status)
+	}
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+)
+
+func benchmarkResultFromMillis(version string, millis ...float64) BenchmarkResult {
+	result := BenchmarkResult{Version: version, Iterations: len(millis)}
+	for _, ms := range millis {
+		result.Executions = append(result.Executions, ExecutionResult{
+			StartTime: time.Unix(0, 0),
+			Duration:  millisToDuration(ms),
+		})
+	}
+	finalizeBenchmarkResult(&result)
+	return result
+}
+
+func TestParseRegressionThreshold(t *testing.T) {
+	for input, expected := range map[string]float64{"10%": 10, "7.5": 7.5, " 0% ": 0} {
+		got, err := parseRegressionThreshold(input)
+		if err != nil || got != expected {
+			t.Errorf("parseRegressionThreshold(%q) = %v, %v; expected %v", input, got, err, expected)
+		}
+	}
+	for _, input := range []string{"", "ten", "-5%"} {
+		if _, err := parseRegressionThreshold(input); err == nil {
+			t.Errorf("expected an error for %q", input)
+		}
+	}
+}
+
+func TestBaselineRoundTripAndRegression(t *testing.T) {
+	original := utils.JFCMBenchmarks
+	utils.JFCMBenchmarks = t.TempDir()
+	t.Cleanup(func() { utils.JFCMBenchmarks = original })
+
+	before := []BenchmarkResult{benchmarkResultFromMillis("2.70.0", 100, 102, 98, 101, 99)}
+	config := BenchmarkConfig{Iterations: 5, Mode: BenchmarkModeSequential}
+	if err := saveBaseline(newBenchmarkBaseline("ci", []string{"rt", "ping"}, config, before)); err != nil {
+		t.Fatalf("unexpected error saving baseline: %v", err)
+	}
+
+	baseline, err := loadBaseline("ci")
+	if err != nil {
+		t.Fatalf("unexpected error loading baseline: %v", err)
+	}
+	if len(baseline.Results) != 1 || len(baseline.Results[0].DurationsMs) != 5 || baseline.Results[0].MedianTimeMs != 100 {
+		t.Fatalf("unexpected baseline contents: %+v", baseline.Results)
+	}
+
+	// A single-version baseline is the reference for any version, as when checking an upgrade
+	after := []BenchmarkResult{
+		benchmarkResultFromMillis("2.70.0", 104, 106, 103, 105, 104),
+		benchmarkResultFromMillis("2.71.0", 130, 128, 131, 129, 132),
+	}
+	comparisons, err := compareWithBaseline(baseline, after, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if comparisons[0].Regressed || !comparisons[1].Regressed || comparisons[1].BaselineVersion != "2.70.0" {
+		t.Errorf("unexpected comparisons: %+v", comparisons)
+	}
+	if !comparisons[1].Significance.Significant {
+		t.Errorf("expected the 30%% slowdown to be significant, got p=%v", comparisons[1].Significance.PValue)
+	}
+	if countRegressions(comparisons) != 1 {
+		t.Errorf("expected one regression, got %d", countRegressions(comparisons))
+	}
+
+	if _, err := loadBaseline("missing"); err == nil {
+		t.Error("expected an error for a missing baseline")
+	}
+}
+
+func TestCompareWithBaselineFailingRuns(t *testing.T) {
+	reference := benchmarkResultFromMillis("2.70.0", 100, 102, 98, 101, 99)
+	baseline := newBenchmarkBaseline("ci", []string{"rt", "ping"}, BenchmarkConfig{Iterations: 5}, []BenchmarkResult{reference})
+
+	// Every run failed fast: the median improved but nothing worked
+	failing := benchmarkResultFromMillis("2.71.0", 5, 5, 5)
+	for i := range failing.Executions {
+		failing.Executions[i].ExitCode = 1
+	}
+	failing.SuccessRate = 0
+	// One of four runs failed
+	flaky := benchmarkResultFromMillis("2.72.0", 100, 100, 100, 100)
+	flaky.SuccessRate = 75
+	// Nothing ran at all
+	empty := BenchmarkResult{Version: "2.73.0"}
+
+	comparisons, err := compareWithBaseline(baseline, []BenchmarkResult{failing, flaky, empty}, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"no successful runs", "success rate 75.0% < 100.0%", "no successful runs"}
+	for i, comparison := range comparisons {
+		if !comparison.Regressed || comparison.Reason != expected[i] {
+			t.Errorf("%s: expected a regression (%s), got %+v", comparison.Version, expected[i], comparison)
+		}
+	}
+}
+
+func TestCompareWithBaselineMissingVersion(t *testing.T) {
+	baseline := &BenchmarkBaseline{Name: "multi", Results: []BaselineResult{{Version: "1.0.0"}, {Version: "2.0.0"}}}
+	if _, err := compareWithBaseline(baseline, []BenchmarkResult{{Version: "3.0.0"}}, 10); err == nil {
+		t.Error("expected an error when a multi-version baseline lacks the version")
+	}
+}
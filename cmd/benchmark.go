@@ -2,7 +2,10 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"math"
 	"os"
 	"sort"
 	"strings"
@@ -15,14 +18,24 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// Benchmark execution modes
+const (
+	BenchmarkModeSequential  = "sequential"
+	BenchmarkModeInterleaved = "interleaved"
+	BenchmarkModeParallel    = "parallel"
+)
+
 type BenchmarkResult struct {
 	Version     string
 	Iterations  int
+	Warmup      int
 	TotalTime   time.Duration
 	AverageTime time.Duration
 	MinTime     time.Duration
 	MaxTime     time.Duration
 	SuccessRate float64
+	Stats       DurationStats
+	Resources   ResourceStats
 	Executions  []ExecutionResult
 }
 
@@ -31,6 +44,7 @@ var Benchmark = &cli.Command{
 	Usage:       descriptions.Benchmark.Usage,
 	ArgsUsage:   "<version1,version2,...> -- <jf-command> [args...]",
 	Description: descriptions.Benchmark.Format(),
+	Subcommands: []*cli.Command{benchmarkRunCommand},
 	Flags: []cli.Flag{
 		&cli.IntFlag{
 			Name:  "iterations",
@@ -57,6 +71,33 @@ var Benchmark = &cli.Command{
 			Usage: "Output format: table, json, csv",
 			Value: "table",
 		},
+		&cli.StringFlag{
+			Name:  "isolate-home",
+			Usage: "Run jf against a temporary copy of the JFrog CLI home (JFROG_CLI_HOME_DIR): per-version or per-run",
+		},
+		&cli.StringFlag{
+			Name:  "mode",
+			Usage: "Execution mode: sequential (one version after another), interleaved (round-robin across versions) or parallel (all versions at once)",
+			Value: BenchmarkModeSequential,
+		},
+		&cli.IntFlag{
+			Name:  "warmup",
+			Usage: "Number of discarded warmup iterations per version",
+			Value: 0,
+		},
+		&cli.StringFlag{
+			Name:  "save-baseline",
+			Usage: "Save the results as a named baseline under ~/.jfcm/benchmarks",
+		},
+		&cli.StringFlag{
+			Name:  "compare-baseline",
+			Usage: "Compare the results against a named baseline and exit non-zero on regression",
+		},
+		&cli.StringFlag{
+			Name:  "max-regression",
+			Usage: "Allowed median slowdown against the baseline, e.g. 10%",
+			Value: "10%",
+		},
 	},
 	Action: func(c *cli.Context) error {
 		// Parse and validate arguments
@@ -72,7 +113,26 @@ var Benchmark = &cli.Command{
 		}
 
 		// Extract configuration
-		config := extractBenchmarkConfig(c)
+		config, err := extractBenchmarkConfig(c)
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+		if err := validateBenchmarkConfig(config); err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		// Load the baseline up front so a missing one fails before anything runs
+		var baseline *BenchmarkBaseline
+		if config.CompareBaseline != "" {
+			baseline, err = loadBaseline(config.CompareBaseline)
+			if err != nil {
+				return cli.Exit(err.Error(), 1)
+			}
+			if strings.Join(baseline.Command, " ") != strings.Join(jfCommand, " ") {
+				fmt.Fprintf(os.Stderr, "⚠️  Warning: baseline '%s' was recorded for 'jf %s'\n",
+					baseline.Name, strings.Join(baseline.Command, " "))
+			}
+		}
 
 		// Run benchmarks
 		results, err := runBenchmarks(resolvedVersions, jfCommand, config)
@@ -83,16 +143,71 @@ var Benchmark = &cli.Command{
 		// Display results
 		displayBenchmarkResults(results, config.Format, config.NoColor, config.Detailed)
 
+		// Keep stdout machine-readable for json and csv
+		out := io.Writer(os.Stdout)
+		if config.Format != "table" {
+			out = os.Stderr
+		}
+
+		if config.SaveBaseline != "" {
+			if err := saveBaseline(newBenchmarkBaseline(config.SaveBaseline, jfCommand, config, results)); err != nil {
+				return cli.Exit(err.Error(), 1)
+			}
+			fmt.Fprintf(out, "\n💾 Baseline '%s' saved to %s\n", config.SaveBaseline, baselinePath(config.SaveBaseline))
+		}
+
+		if baseline != nil {
+			comparisons, err := compareWithBaseline(baseline, results, config.MaxRegression)
+			if err != nil {
+				return cli.Exit(err.Error(), 1)
+			}
+			displayBaselineComparison(out, baseline, comparisons, config.MaxRegression, config.NoColor)
+			if regressions := countRegressions(comparisons); regressions > 0 {
+				return cli.Exit(fmt.Sprintf("❌ %d version(s) regressed by more than %.1f%% against baseline '%s'",
+					regressions, config.MaxRegression, baseline.Name), 1)
+			}
+		}
+
 		return nil
 	},
 }
 
 type BenchmarkConfig struct {
-	Iterations int
-	Timeout    time.Duration
-	Format     string
-	NoColor    bool
-	Detailed   bool
+	Iterations  int
+	Warmup      int
+	Timeout     time.Duration
+	Format      string
+	NoColor     bool
+	Detailed    bool
+	IsolateHome string
+	Mode        string
+	Env         []string // extra KEY=VALUE variables for every jf run
+
+	SaveBaseline    string
+	CompareBaseline string
+	MaxRegression   float64
+}
+
+func validateBenchmarkConfig(config BenchmarkConfig) error {
+	if config.Iterations < 1 {
+		return fmt.Errorf("❌ --iterations must be at least 1")
+	}
+	if config.Warmup < 0 {
+		return fmt.Errorf("❌ --warmup must not be negative")
+	}
+	switch config.Mode {
+	case BenchmarkModeSequential, BenchmarkModeInterleaved, BenchmarkModeParallel:
+	default:
+		return fmt.Errorf("❌ unsupported mode '%s' (supported: sequential, interleaved, parallel)", config.Mode)
+	}
+	for _, name := range []string{config.SaveBaseline, config.CompareBaseline} {
+		if name != "" {
+			if err := validateBaselineName(name); err != nil {
+				return err
+			}
+		}
+	}
+	return validateIsolateHome(config.IsolateHome)
 }
 
 func parseArguments(args []string) (versions []string, jfCommand []string, err error) {
@@ -152,14 +267,36 @@ func validateVersions(versions []string) ([]string, error) {
 	return resolvedVersions, nil
 }
 
-func extractBenchmarkConfig(c *cli.Context) BenchmarkConfig {
-	return BenchmarkConfig{
-		Iterations: c.Int("iterations"),
-		Timeout:    time.Duration(c.Int("timeout")) * time.Second,
-		Format:     c.String("format"),
-		NoColor:    c.Bool("no-color"),
-		Detailed:   c.Bool("detailed"),
+func extractBenchmarkConfig(c *cli.Context) (BenchmarkConfig, error) {
+	maxRegression, err := parseRegressionThreshold(c.String("max-regression"))
+	if err != nil {
+		return BenchmarkConfig{}, err
+	}
+	if c.IsSet("max-regression") && !c.IsSet("compare-baseline") {
+		return BenchmarkConfig{}, fmt.Errorf("❌ --max-regression requires --compare-baseline")
 	}
+
+	return BenchmarkConfig{
+		Iterations:  c.Int("iterations"),
+		Timeout:     time.Duration(c.Int("timeout")) * time.Second,
+		Format:      c.String("format"),
+		NoColor:     c.Bool("no-color"),
+		Detailed:    c.Bool("detailed"),
+		IsolateHome: c.String("isolate-home"),
+		Mode:        c.String("mode"),
+		Warmup:      c.Int("warmup"),
+
+		SaveBaseline:    c.String("save-baseline"),
+		CompareBaseline: c.String("compare-baseline"),
+		MaxRegression:   maxRegression,
+	}, nil
+}
+
+// benchmarkTarget holds the per-version state of a benchmark run
+type benchmarkTarget struct {
+	version string
+	home    *JFHomeSnapshot // shared by all iterations with --isolate-home per-version
+	result  BenchmarkResult
 }
 
 func runBenchmarks(versions []string, jfCommand []string, config BenchmarkConfig) ([]BenchmarkResult, error) {
@@ -167,63 +304,176 @@ func runBenchmarks(versions []string, jfCommand []string, config BenchmarkConfig
 	if config.Format == "table" {
 		fmt.Printf("🏁 Benchmarking JFrog CLI versions: %s\n", strings.Join(versions, ", "))
 		fmt.Printf("📝 Command: jf %s\n", strings.Join(jfCommand, " "))
-		fmt.Printf("🔄 Iterations: %d per version\n\n", config.Iterations)
+		fmt.Printf("🔄 Iterations: %d per version", config.Iterations)
+		if config.Warmup > 0 {
+			fmt.Printf(" (+%d warmup)", config.Warmup)
+		}
+		fmt.Printf(" • Mode: %s\n\n", config.Mode)
 	}
 
-	// Run benchmarks
-	results := make([]BenchmarkResult, len(versions))
-	g, ctx := errgroup.WithContext(context.Background())
-
+	targets := make([]*benchmarkTarget, len(versions))
 	for i, version := range versions {
-		i, version := i, version
-		g.Go(func() error {
-			result, err := runBenchmark(ctx, version, jfCommand, config.Iterations, config.Timeout)
-			results[i] = result
+		targets[i] = &benchmarkTarget{
+			version: version,
+			result: BenchmarkResult{
+				Version:    version,
+				Iterations: config.Iterations,
+				Warmup:     config.Warmup,
+				Executions: make([]ExecutionResult, config.Iterations),
+			},
+		}
+		if config.IsolateHome == IsolateHomePerVersion {
+			snapshot, err := NewJFHomeSnapshot()
+			if err != nil {
+				return nil, err
+			}
+			defer snapshot.Cleanup()
+			targets[i].home = snapshot
+		}
+	}
+
+	err := scheduleBenchmark(context.Background(), targets, jfCommand, config)
+
+	results := make([]BenchmarkResult, len(targets))
+	for i, target := range targets {
+		finalizeBenchmarkResult(&target.result)
+		results[i] = target.result
+	}
+	return results, err
+}
+
+// scheduleBenchmark runs the warmup and measured iterations of all targets in the configured mode.
+// Sequential runs one version at a time, interleaved alternates versions every iteration so that
+// drifting machine load affects all of them alike, and parallel runs all versions concurrently.
+func scheduleBenchmark(ctx context.Context, targets []*benchmarkTarget, jfCommand []string, config BenchmarkConfig) error {
+	run := func(ctx context.Context, target *benchmarkTarget, iteration int) error {
+		exec, err := runBenchmarkIteration(ctx, target, jfCommand, config)
+		if err != nil {
 			return err
-		})
+		}
+		if iteration >= 0 {
+			target.result.Executions[iteration] = exec
+		}
+		return nil
 	}
 
-	return results, g.Wait()
+	switch config.Mode {
+	case BenchmarkModeParallel:
+		g, gctx := errgroup.WithContext(ctx)
+		for _, target := range targets {
+			target := target
+			g.Go(func() error {
+				for w := 0; w < config.Warmup; w++ {
+					if err := run(gctx, target, -1); err != nil {
+						return err
+					}
+				}
+				for i := 0; i < config.Iterations; i++ {
+					if err := run(gctx, target, i); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		}
+		return g.Wait()
+
+	case BenchmarkModeInterleaved:
+		for w := 0; w < config.Warmup; w++ {
+			for _, target := range targets {
+				if err := run(ctx, target, -1); err != nil {
+					return err
+				}
+			}
+		}
+		for i := 0; i < config.Iterations; i++ {
+			for _, target := range targets {
+				if err := run(ctx, target, i); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+
+	default:
+		for _, target := range targets {
+			for w := 0; w < config.Warmup; w++ {
+				if err := run(ctx, target, -1); err != nil {
+					return err
+				}
+			}
+			for i := 0; i < config.Iterations; i++ {
+				if err := run(ctx, target, i); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
 }
 
-func runBenchmark(ctx context.Context, version string, jfCommand []string, iterations int, timeout time.Duration) (BenchmarkResult, error) {
-	result := BenchmarkResult{
-		Version:    version,
-		Iterations: iterations,
-		MinTime:    time.Hour,
-		Executions: make([]ExecutionResult, iterations),
+// runBenchmarkIteration executes the benchmarked command once for a target
+func runBenchmarkIteration(ctx context.Context, target *benchmarkTarget, jfCommand []string, config BenchmarkConfig) (ExecutionResult, error) {
+	home := target.home
+	if config.IsolateHome == IsolateHomePerRun {
+		snapshot, err := NewJFHomeSnapshot()
+		if err != nil {
+			return ExecutionResult{}, err
+		}
+		defer snapshot.Cleanup()
+		home = snapshot
 	}
 
-	successCount := 0
+	timeoutCtx, cancel := context.WithTimeout(ctx, config.Timeout)
+	defer cancel()
+
+	// Failures of jf itself are recorded in the execution result
+	return executeJFCommand(timeoutCtx, target.version, jfCommand, append(home.Env(), config.Env...))
+}
 
-	for i := 0; i < iterations; i++ {
-		timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
-		exec, err := executeJFCommand(timeoutCtx, version, jfCommand)
-		cancel()
+// finalizeBenchmarkResult computes the aggregate timings of the recorded executions
+func finalizeBenchmarkResult(result *BenchmarkResult) {
+	var durations []time.Duration
+	var usage []ResourceUsage
+	successCount := 0
 
-		result.Executions[i] = exec
+	for _, exec := range result.Executions {
+		if exec.StartTime.IsZero() {
+			// Not executed because the run was aborted
+			continue
+		}
+		durations = append(durations, exec.Duration)
+		usage = append(usage, exec.Usage)
 		result.TotalTime += exec.Duration
-
 		if exec.ExitCode == 0 {
 			successCount++
 		}
-
-		if exec.Duration < result.MinTime {
+		if result.MinTime == 0 || exec.Duration < result.MinTime {
 			result.MinTime = exec.Duration
 		}
 		if exec.Duration > result.MaxTime {
 			result.MaxTime = exec.Duration
 		}
-
-		if err != nil {
-			fmt.Printf("⚠️  Iteration %d for %s failed: %v\n", i+1, version, err)
-		}
 	}
 
-	result.AverageTime = result.TotalTime / time.Duration(iterations)
-	result.SuccessRate = float64(successCount) / float64(iterations) * 100
+	if len(durations) == 0 {
+		return
+	}
+	result.AverageTime = result.TotalTime / time.Duration(len(durations))
+	result.SuccessRate = float64(successCount) / float64(len(durations)) * 100
+	result.Stats = computeDurationStats(durations)
+	result.Resources = aggregateResourceUsage(usage)
+}
 
-	return result, nil
+// executionDurations returns the durations of the executions that ran
+func executionDurations(result BenchmarkResult) []time.Duration {
+	var durations []time.Duration
+	for _, exec := range result.Executions {
+		if !exec.StartTime.IsZero() {
+			durations = append(durations, exec.Duration)
+		}
+	}
+	return durations
 }
 
 func displayBenchmarkResults(results []BenchmarkResult, format string, noColor, detailed bool) {
@@ -331,14 +581,39 @@ func displayEnhancedBenchmarkResults(results []BenchmarkResult, noColor, detaile
 		// Performance metrics with better contrast
 		metrics := fmt.Sprintf(
 			"⚡ Avg: %s\n"+
+				"📍 Median: %s\n"+
+				"📐 p90/p95: %s / %s\n"+
+				"📏 StdDev: ±%s\n"+
+				"🎯 95%% CI: %s – %s\n"+
 				"🏃 Min: %s\n"+
 				"🐌 Max: %s\n"+
 				"⏱️  Total: %s",
 			lipgloss.NewStyle().Foreground(lipgloss.Color("#E5E7EB")).Bold(true).Render(formatDuration(result.AverageTime)),
+			lipgloss.NewStyle().Foreground(lipgloss.Color("#E5E7EB")).Bold(true).Render(formatDuration(result.Stats.Median)),
+			formatDuration(result.Stats.P90),
+			formatDuration(result.Stats.P95),
+			formatDuration(result.Stats.StdDev),
+			formatDuration(result.Stats.CILow),
+			formatDuration(result.Stats.CIHigh),
 			lipgloss.NewStyle().Foreground(jfrogGreen).Bold(true).Render(formatDuration(result.MinTime)),
 			lipgloss.NewStyle().Foreground(jfrogOrange).Bold(true).Render(formatDuration(result.MaxTime)),
 			lipgloss.NewStyle().Foreground(lipgloss.Color("#F3F4F6")).Render(formatDuration(result.TotalTime)),
 		)
+		if result.Stats.Outliers > 0 {
+			metrics += "\n" + lipgloss.NewStyle().Foreground(jfrogOrange).Render(fmt.Sprintf("🚩 Outliers: %d", result.Stats.Outliers))
+		}
+
+		// Resource usage of the jf process
+		metrics += fmt.Sprintf("\n\n🧮 User CPU: %s\n🧮 Sys CPU: %s",
+			formatDuration(result.Resources.AvgUserTime),
+			formatDuration(result.Resources.AvgSystemTime))
+		if result.Resources.PeakMaxRSS > 0 {
+			metrics += fmt.Sprintf("\n💾 RSS: %s (peak %s)\n🔀 Ctx sw: %.0f vol / %.0f invol",
+				formatBytes(result.Resources.AvgMaxRSS),
+				formatBytes(result.Resources.PeakMaxRSS),
+				result.Resources.AvgVoluntaryContextSwitches,
+				result.Resources.AvgInvoluntaryContextSwitches)
+		}
 
 		// Success rate with visual indicator
 		successColor := jfrogGreen
@@ -357,11 +632,14 @@ func displayEnhancedBenchmarkResults(results []BenchmarkResult, noColor, detaile
 			lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(fmt.Sprintf("%.1f%%", result.SuccessRate)))
 
 		// Iterations info
-		iterationsInfo := lipgloss.NewStyle().Foreground(mutedGray).Italic(true).
-			Render(fmt.Sprintf("📊 %d iterations", result.Iterations))
+		iterations := fmt.Sprintf("📊 %d iterations", result.Iterations)
+		if result.Warmup > 0 {
+			iterations += fmt.Sprintf(" (+%d warmup)", result.Warmup)
+		}
+		iterationsInfo := lipgloss.NewStyle().Foreground(mutedGray).Italic(true).Render(iterations)
 
 		cardContent := versionHeader + "\n\n" + metrics + "\n" + successRate + "\n" + iterationsInfo
-		card := style.Width(28).Render(cardContent)
+		card := style.Width(34).Render(cardContent)
 		cards = append(cards, card)
 	}
 
@@ -407,6 +685,52 @@ func createBenchmarkSummary(results []BenchmarkResult, jfrogGreen, jfrogOrange,
 			speedDiff)
 	}
 
+	// Statistical significance of each version's difference to the fastest one
+	if len(results) > 1 {
+		content += "\n"
+		for _, result := range results[1:] {
+			test := welchTTest(executionDurations(winner), executionDurations(result))
+			verdict := lipgloss.NewStyle().Foreground(mutedGray).Render("not enough iterations to test significance")
+			if test.Valid && test.Significant {
+				verdict = lipgloss.NewStyle().Foreground(jfrogOrange).Bold(true).
+					Render(fmt.Sprintf("statistically significant (p=%.3f)", test.PValue))
+			} else if test.Valid {
+				verdict = lipgloss.NewStyle().Foreground(mutedGray).
+					Render(fmt.Sprintf("not significant, may be noise (p=%.3f)", test.PValue))
+			}
+			content += fmt.Sprintf("⚖️  %s vs %s: +%s (%+.1f%%), %s\n",
+				result.Version,
+				winner.Version,
+				formatDuration(test.Difference),
+				percentChange(float64(winner.AverageTime), float64(result.AverageTime)),
+				verdict)
+		}
+	}
+
+	// Memory differences matter as much as time: compare each version to the leanest one
+	if len(results) > 1 {
+		leanest := results[0]
+		for _, result := range results[1:] {
+			if result.Resources.AvgMaxRSS > 0 && result.Resources.AvgMaxRSS < leanest.Resources.AvgMaxRSS {
+				leanest = result
+			}
+		}
+		if leanest.Resources.AvgMaxRSS > 0 {
+			content += "\n"
+			for _, result := range results {
+				if result.Version == leanest.Version {
+					continue
+				}
+				content += fmt.Sprintf("💾 %s vs %s: %s vs %s max RSS (%+.1f%%)\n",
+					result.Version,
+					leanest.Version,
+					formatBytes(result.Resources.AvgMaxRSS),
+					formatBytes(leanest.Resources.AvgMaxRSS),
+					percentChange(float64(leanest.Resources.AvgMaxRSS), float64(result.Resources.AvgMaxRSS)))
+			}
+		}
+	}
+
 	// Overall stats
 	totalTime := time.Duration(0)
 	totalIterations := 0
@@ -454,6 +778,12 @@ func displayDetailedBenchmarkLogs(results []BenchmarkResult, jfrogGreen, jfrogOr
 				lipgloss.NewStyle().Foreground(statusColor).Render(status),
 				formatDuration(exec.Duration))
 
+			line += fmt.Sprintf("  cpu %s usr / %s sys", formatDuration(exec.Usage.UserTime), formatDuration(exec.Usage.SystemTime))
+			if exec.Usage.MaxRSS > 0 {
+				line += fmt.Sprintf("  rss %s  ctx %d/%d", formatBytes(exec.Usage.MaxRSS),
+					exec.Usage.VoluntaryContextSwitches, exec.Usage.InvoluntaryContextSwitches)
+			}
+
 			if exec.ExitCode != 0 {
 				line += lipgloss.NewStyle().Foreground(jfrogOrange).Render(fmt.Sprintf(" (exit %d)", exec.ExitCode))
 			}
@@ -463,40 +793,180 @@ func displayDetailedBenchmarkLogs(results []BenchmarkResult, jfrogGreen, jfrogOr
 	}
 }
 
-func displayBenchmarkJSON(results []BenchmarkResult) {
-	fmt.Printf("{\n")
-	fmt.Printf("  \"benchmark_results\": [\n")
-	for i, result := range results {
-		fmt.Printf("    {\n")
-		fmt.Printf("      \"version\": \"%s\",\n", result.Version)
-		fmt.Printf("      \"iterations\": %d,\n", result.Iterations)
-		fmt.Printf("      \"total_time_ms\": %.2f,\n", float64(result.TotalTime.Nanoseconds())/1e6)
-		fmt.Printf("      \"average_time_ms\": %.2f,\n", float64(result.AverageTime.Nanoseconds())/1e6)
-		fmt.Printf("      \"min_time_ms\": %.2f,\n", float64(result.MinTime.Nanoseconds())/1e6)
-		fmt.Printf("      \"max_time_ms\": %.2f,\n", float64(result.MaxTime.Nanoseconds())/1e6)
-		fmt.Printf("      \"success_rate\": %.2f\n", result.SuccessRate)
-		if i < len(results)-1 {
-			fmt.Printf("    },\n")
-		} else {
-			fmt.Printf("    }\n")
+// benchmarkJSONResult is the JSON representation of a benchmark result
+type benchmarkJSONResult struct {
+	Version       string  `json:"version"`
+	Iterations    int     `json:"iterations"`
+	Warmup        int     `json:"warmup"`
+	TotalTimeMs   float64 `json:"total_time_ms"`
+	AverageTimeMs float64 `json:"average_time_ms"`
+	MinTimeMs     float64 `json:"min_time_ms"`
+	MaxTimeMs     float64 `json:"max_time_ms"`
+	MedianTimeMs  float64 `json:"median_time_ms"`
+	P90TimeMs     float64 `json:"p90_time_ms"`
+	P95TimeMs     float64 `json:"p95_time_ms"`
+	StdDevMs      float64 `json:"stddev_ms"`
+	CI95LowMs     float64 `json:"ci95_low_ms"`
+	CI95HighMs    float64 `json:"ci95_high_ms"`
+	Outliers      int     `json:"outliers"`
+	SuccessRate   float64 `json:"success_rate"`
+
+	AvgUserTimeMs             float64 `json:"avg_user_time_ms"`
+	AvgSystemTimeMs           float64 `json:"avg_system_time_ms"`
+	AvgMaxRSSBytes            int64   `json:"avg_max_rss_bytes"`
+	PeakMaxRSSBytes           int64   `json:"peak_max_rss_bytes"`
+	AvgVoluntaryCtxSwitches   float64 `json:"avg_voluntary_ctx_switches"`
+	AvgInvoluntaryCtxSwitches float64 `json:"avg_involuntary_ctx_switches"`
+}
+
+// benchmarkJSONComparison is the significance test of a version against the fastest one
+type benchmarkJSONComparison struct {
+	Baseline     string  `json:"baseline"`
+	Version      string  `json:"version"`
+	DifferenceMs float64 `json:"difference_ms"`
+	PValue       float64 `json:"p_value"`
+	Significant  bool    `json:"significant"`
+}
+
+// benchmarkJSONReport is the JSON document of a single benchmark run
+type benchmarkJSONReport struct {
+	Results     []benchmarkJSONResult     `json:"benchmark_results"`
+	Comparisons []benchmarkJSONComparison `json:"comparisons,omitempty"`
+}
+
+// newBenchmarkJSONReport converts results into their JSON representation
+func newBenchmarkJSONReport(results []BenchmarkResult) benchmarkJSONReport {
+	var report benchmarkJSONReport
+
+	for _, result := range results {
+		report.Results = append(report.Results, benchmarkJSONResult{
+			Version:       result.Version,
+			Iterations:    result.Iterations,
+			Warmup:        result.Warmup,
+			TotalTimeMs:   durationMs(result.TotalTime),
+			AverageTimeMs: durationMs(result.AverageTime),
+			MinTimeMs:     durationMs(result.MinTime),
+			MaxTimeMs:     durationMs(result.MaxTime),
+			MedianTimeMs:  durationMs(result.Stats.Median),
+			P90TimeMs:     durationMs(result.Stats.P90),
+			P95TimeMs:     durationMs(result.Stats.P95),
+			StdDevMs:      durationMs(result.Stats.StdDev),
+			CI95LowMs:     durationMs(result.Stats.CILow),
+			CI95HighMs:    durationMs(result.Stats.CIHigh),
+			Outliers:      result.Stats.Outliers,
+			SuccessRate:   roundTo2(result.SuccessRate),
+
+			AvgUserTimeMs:             durationMs(result.Resources.AvgUserTime),
+			AvgSystemTimeMs:           durationMs(result.Resources.AvgSystemTime),
+			AvgMaxRSSBytes:            result.Resources.AvgMaxRSS,
+			PeakMaxRSSBytes:           result.Resources.PeakMaxRSS,
+			AvgVoluntaryCtxSwitches:   roundTo2(result.Resources.AvgVoluntaryContextSwitches),
+			AvgInvoluntaryCtxSwitches: roundTo2(result.Resources.AvgInvoluntaryContextSwitches),
+		})
+	}
+
+	if fastest, ok := fastestResult(results); ok {
+		for _, result := range results {
+			if result.Version == fastest.Version {
+				continue
+			}
+			test := welchTTest(executionDurations(fastest), executionDurations(result))
+			if !test.Valid {
+				continue
+			}
+			report.Comparisons = append(report.Comparisons, benchmarkJSONComparison{
+				Baseline:     fastest.Version,
+				Version:      result.Version,
+				DifferenceMs: durationMs(test.Difference),
+				PValue:       test.PValue,
+				Significant:  test.Significant,
+			})
 		}
 	}
-	fmt.Printf("  ]\n")
-	fmt.Printf("}\n")
+
+	return report
+}
+
+func displayBenchmarkJSON(results []BenchmarkResult) {
+	printJSON(newBenchmarkJSONReport(results))
+}
+
+// printJSON prints a value as indented JSON
+func printJSON(v interface{}) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to encode benchmark results: %v\n", err)
+		return
+	}
+	fmt.Println(string(data))
 }
 
+// benchmarkCSVHeader lists the CSV columns of a benchmark result
+const benchmarkCSVHeader = "version,iterations,total_time_ms,average_time_ms,min_time_ms,max_time_ms,success_rate,median_time_ms,p90_time_ms,p95_time_ms,stddev_ms,ci95_low_ms,ci95_high_ms,outliers,warmup,avg_user_time_ms,avg_system_time_ms,avg_max_rss_bytes,peak_max_rss_bytes,avg_voluntary_ctx_switches,avg_involuntary_ctx_switches"
+
 func displayBenchmarkCSV(results []BenchmarkResult) {
-	fmt.Printf("version,iterations,total_time_ms,average_time_ms,min_time_ms,max_time_ms,success_rate\n")
+	fmt.Println(benchmarkCSVHeader)
 	for _, result := range results {
-		fmt.Printf("%s,%d,%.2f,%.2f,%.2f,%.2f,%.2f\n",
-			result.Version,
-			result.Iterations,
-			float64(result.TotalTime.Nanoseconds())/1e6,
-			float64(result.AverageTime.Nanoseconds())/1e6,
-			float64(result.MinTime.Nanoseconds())/1e6,
-			float64(result.MaxTime.Nanoseconds())/1e6,
-			result.SuccessRate)
+		fmt.Println(benchmarkCSVRow(result))
+	}
+}
+
+// benchmarkCSVRow formats a result as a CSV row matching benchmarkCSVHeader
+func benchmarkCSVRow(result BenchmarkResult) string {
+	return fmt.Sprintf("%s,%d,%.2f,%.2f,%.2f,%.2f,%.2f,%.2f,%.2f,%.2f,%.2f,%.2f,%.2f,%d,%d,%.2f,%.2f,%d,%d,%.2f,%.2f",
+		result.Version,
+		result.Iterations,
+		float64(result.TotalTime.Nanoseconds())/1e6,
+		float64(result.AverageTime.Nanoseconds())/1e6,
+		float64(result.MinTime.Nanoseconds())/1e6,
+		float64(result.MaxTime.Nanoseconds())/1e6,
+		result.SuccessRate,
+		float64(result.Stats.Median.Nanoseconds())/1e6,
+		float64(result.Stats.P90.Nanoseconds())/1e6,
+		float64(result.Stats.P95.Nanoseconds())/1e6,
+		float64(result.Stats.StdDev.Nanoseconds())/1e6,
+		float64(result.Stats.CILow.Nanoseconds())/1e6,
+		float64(result.Stats.CIHigh.Nanoseconds())/1e6,
+		result.Stats.Outliers,
+		result.Warmup,
+		float64(result.Resources.AvgUserTime.Nanoseconds())/1e6,
+		float64(result.Resources.AvgSystemTime.Nanoseconds())/1e6,
+		result.Resources.AvgMaxRSS,
+		result.Resources.PeakMaxRSS,
+		result.Resources.AvgVoluntaryContextSwitches,
+		result.Resources.AvgInvoluntaryContextSwitches)
+}
+
+// fastestResult returns the result with the lowest average time
+func fastestResult(results []BenchmarkResult) (BenchmarkResult, bool) {
+	if len(results) == 0 {
+		return BenchmarkResult{}, false
 	}
+	fastest := results[0]
+	for _, result := range results[1:] {
+		if result.AverageTime < fastest.AverageTime {
+			fastest = result
+		}
+	}
+	return fastest, true
+}
+
+// percentChange returns the relative change from base to value in percent
+func percentChange(base, value float64) float64 {
+	if base == 0 {
+		return 0
+	}
+	return (value - base) / base * 100
+}
+
+// durationMs converts a duration to milliseconds rounded to two decimals
+func durationMs(d time.Duration) float64 {
+	return roundTo2(float64(d.Nanoseconds()) / 1e6)
+}
+
+// roundTo2 rounds a value to two decimals
+func roundTo2(v float64) float64 {
+	return math.Round(v*100) / 100
 }
 
 func formatDuration(d time.Duration) string {
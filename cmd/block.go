@@ -12,7 +12,7 @@ var Block = &cli.Command{
 	Usage:       "Block a specific version of jf cli",
 	ArgsUsage:   "<version>",
 	Description: `Block a specific version of jf cli from being used`,
-	Action: func(c *cli.Context) error {
+	Action: withStateLock(func(c *cli.Context) error {
 		if c.Args().Len() != 1 {
 			return cli.Exit("Please provide a specific version to block", 1)
 		}
@@ -31,5 +31,5 @@ var Block = &cli.Command{
 
 		fmt.Printf("✅ Successfully blocked version %s\n", version)
 		return nil
-	},
+	}),
 }
@@ -0,0 +1,771 @@
+package cmd
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/descriptions"
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+	"github.com/jfrog/jfrog-cli-manager/internal"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+	"github.com/urfave/cli/v2"
+)
+
+// Files at the root of a bundle
+const (
+	BundleManifestFile  = "manifest.json"
+	BundleChecksumsFile = "SHA256SUMS"
+	bundleFormatVersion = 1
+)
+
+// Outcomes of importing a bundled version
+const (
+	BundleImported = "imported"
+	BundleSkipped  = "already installed"
+	BundleConflict = "conflict"
+)
+
+// bundleChangelogTimeout bounds caching the changelog of the bundled versions
+const bundleChangelogTimeout = 60 * time.Second
+
+var Bundle = &cli.Command{
+	Name:        "bundle",
+	Usage:       descriptions.Bundle.Usage,
+	Description: descriptions.Bundle.Format(),
+	Subcommands: []*cli.Command{
+		{
+			Name:  "export",
+			Usage: "Write versions for one or more platforms, with checksums, aliases, the block list and the changelog cache, to a tarball",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:  "versions",
+					Usage: "Versions to bundle, comma-separated (default: every installed version)",
+				},
+				&cli.StringSliceFlag{
+					Name:  "platforms",
+					Usage: "Platforms to bundle, comma-separated, e.g. linux-amd64,linux-arm64 (default: this machine's)",
+				},
+				&cli.StringFlag{
+					Name:     "output",
+					Aliases:  []string{"o"},
+					Usage:    "Bundle file to write (.tar.gz or .tgz)",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  "no-changelog",
+					Usage: "Do not fetch the changelog of the bundled versions into the cache",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				output := c.String("output")
+				if !isTarballPath(output) {
+					return cli.Exit("❌ --output must end with .tar.gz or .tgz", 1)
+				}
+
+				platforms, err := bundlePlatforms(c.StringSlice("platforms"))
+				if err != nil {
+					return cli.Exit(fmt.Sprintf("❌ %v", err), 1)
+				}
+				versions, err := resolveInstallVersions(c.StringSlice("versions"))
+				if err != nil {
+					return cli.Exit(fmt.Sprintf("❌ %v", err), 1)
+				}
+				if len(versions) == 0 {
+					if versions, err = utils.GetInstalledVersions(); err != nil && !os.IsNotExist(err) {
+						return fmt.Errorf("failed to list installed versions: %w", err)
+					}
+				}
+				if len(versions) == 0 {
+					return cli.Exit("❌ No versions to bundle; pass --versions", 1)
+				}
+
+				for _, platform := range platforms {
+					if err := fetchBundleBuilds(platform, versions); err != nil {
+						return err
+					}
+				}
+				if !c.Bool("no-changelog") {
+					cacheBundleChangelog(versions)
+				}
+
+				files, manifest, err := planBundle(versions, platforms)
+				if err != nil {
+					return err
+				}
+				if err := writeExportTarball(output, files); err != nil {
+					return fmt.Errorf("failed to write %s: %w", output, err)
+				}
+
+				platformNames := make([]string, len(platforms))
+				for i, platform := range platforms {
+					platformNames[i] = platform.Name()
+				}
+				fmt.Printf("✅ Bundled %d builds (%s for %s) into %s\n", len(manifest.Versions),
+					strings.Join(versions, ", "), strings.Join(platformNames, ", "), output)
+				fmt.Printf("💡 Import it on the offline side with: jfcm bundle import %s\n", filepath.Base(output))
+				return nil
+			},
+		},
+		{
+			Name:      "import",
+			Usage:     "Verify a bundle and install its versions, aliases, block list and changelog cache",
+			ArgsUsage: "<bundle.tar.gz>",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:    "force",
+					Aliases: []string{"f"},
+					Usage:   "Replace installed versions and aliases that differ from the bundle",
+				},
+			},
+			Action: withStateLock(func(c *cli.Context) error {
+				if c.Args().Len() != 1 {
+					return cli.Exit("Usage: jfcm bundle import <bundle.tar.gz>", 1)
+				}
+
+				report, err := importBundle(c.Args().Get(0), c.Bool("force"))
+				if err != nil {
+					return cli.Exit(fmt.Sprintf("❌ %v", err), 1)
+				}
+				displayBundleImport(report)
+				if report.Conflicts() > 0 {
+					return cli.Exit("❌ Some versions or aliases differ from the bundle; re-run with --force to replace them", 1)
+				}
+				return nil
+			}),
+		},
+	},
+}
+
+// BundleManifest describes the builds in a bundle
+type BundleManifest struct {
+	FormatVersion int             `json:"format_version"`
+	CreatedAt     time.Time       `json:"created_at"`
+	Versions      []BundleVersion `json:"versions"`
+}
+
+// BundleVersion is one build of a version for a platform
+type BundleVersion struct {
+	Version  string `json:"version"`
+	Platform string `json:"platform"`
+	SHA256   string `json:"sha256"`
+	Size     int64  `json:"size"`
+}
+
+// dir returns the directory of the build inside the bundle
+func (v BundleVersion) dir() string {
+	return path.Join(utils.PlatformsDir, v.Platform, utils.VersionsDir, v.Version)
+}
+
+// BundleVersionResult is the outcome of importing one bundled build
+type BundleVersionResult struct {
+	BundleVersion
+	Status string
+}
+
+// BundleImportReport summarizes an import
+type BundleImportReport struct {
+	Versions       []BundleVersionResult
+	Aliases        []string
+	AliasConflicts []string
+	Blocked        []string
+	CacheEntries   int
+}
+
+// Conflicts returns how many versions and aliases were left alone because they differ from the bundle
+func (r *BundleImportReport) Conflicts() int {
+	conflicts := len(r.AliasConflicts)
+	for _, result := range r.Versions {
+		if result.Status == BundleConflict {
+			conflicts++
+		}
+	}
+	return conflicts
+}
+
+// bundlePlatforms parses --platforms, defaulting to the host platform
+func bundlePlatforms(values []string) ([]internal.Platform, error) {
+	if len(values) == 0 {
+		host, err := installPlatform("")
+		return []internal.Platform{host}, err
+	}
+	var platforms []internal.Platform
+	for _, value := range values {
+		platform, err := internal.ParsePlatform(strings.TrimSpace(value))
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(platforms, platform) {
+			platforms = append(platforms, platform)
+		}
+	}
+	return platforms, nil
+}
+
+// fetchBundleBuilds downloads the builds of a platform that are not installed or cached yet
+func fetchBundleBuilds(platform internal.Platform, versions []string) error {
+	versionsDir := utils.PlatformVersionsDir(platform.Name())
+	var missing []string
+	for _, version := range versions {
+		if _, err := os.Stat(filepath.Join(versionsDir, version, utils.BinaryName)); err != nil {
+			missing = append(missing, version)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	fmt.Printf("📦 Downloading %s for %s\n", strings.Join(missing, ", "), platform.Name())
+	downloader := internal.NewDownloader(os.Stderr)
+	downloader.NewProgress = func(label string) internal.ProgressReporter {
+		return internal.NewTextProgressReporter(os.Stderr, label)
+	}
+	results := installVersions(versionsDir, missing, defaultInstallParallel, func(version string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), internal.DownloadTimeout)
+		defer cancel()
+		return internal.DownloadAndInstallPlatform(ctx, version, platform, downloader)
+	})
+	if failed := countInstallFailures(results); failed > 0 {
+		displayInstallResults(results)
+		return cli.Exit(fmt.Sprintf("❌ %d builds for %s could not be downloaded", failed, platform.Name()), 1)
+	}
+	return nil
+}
+
+// cacheBundleChangelog fetches the release notes spanning the bundled versions so the GitHub cache
+// included in the bundle can answer `compare changelog --offline` on the other side
+func cacheBundleChangelog(versions []string) {
+	var semvers []utils.Version
+	for _, version := range versions {
+		if parsed, err := utils.ParseVersion(version); err == nil {
+			semvers = append(semvers, parsed)
+		}
+	}
+	if len(semvers) < 2 {
+		return
+	}
+	sort.Slice(semvers, func(i, j int) bool { return semvers[i].Compare(semvers[j]) < 0 })
+
+	ctx, cancel := context.WithTimeout(context.Background(), bundleChangelogTimeout)
+	defer cancel()
+	from, to := "v"+semvers[0].String(), "v"+semvers[len(semvers)-1].String()
+	fmt.Printf("📖 Caching the changelog from %s to %s\n", from, to)
+	if _, err := FetchReleaseNotes(ctx, NewGitHubClient(false), DefaultChangelogOwner, DefaultChangelogRepo, from, to); err != nil {
+		fmt.Printf("⚠️  Could not cache the changelog: %v\n", err)
+	}
+}
+
+// planBundle lists the files of a bundle: the builds with their metadata, the aliases pointing at
+// the bundled versions, the block list, the GitHub response cache, the manifest and the checksums
+func planBundle(versions []string, platforms []internal.Platform) ([]exportFile, *BundleManifest, error) {
+	manifest := &BundleManifest{FormatVersion: bundleFormatVersion, CreatedAt: time.Now().UTC()}
+	var files []exportFile
+
+	for _, version := range versions {
+		if err := utils.ValidateVersionName(version); err != nil {
+			return nil, nil, fmt.Errorf("only released versions can be bundled: %w", err)
+		}
+	}
+	for _, platform := range platforms {
+		versionsDir := utils.PlatformVersionsDir(platform.Name())
+		for _, version := range versions {
+			binPath := filepath.Join(versionsDir, version, utils.BinaryName)
+			digest, size, err := utils.HashFile(binPath)
+			if err != nil {
+				return nil, nil, fmt.Errorf("version %s is not installed for %s: %w", version, platform.Name(), err)
+			}
+			// Refuse to spread a binary that no longer matches the digest recorded at install time
+			meta, err := utils.LoadVersionMetaIn(versionsDir, version)
+			if err != nil && !os.IsNotExist(err) {
+				return nil, nil, err
+			}
+			if meta != nil && meta.SHA256 != "" && meta.SHA256 != digest {
+				return nil, nil, fmt.Errorf("the %s binary of %s does not match its recorded digest; run 'jfcm verify'", platform.Name(), version)
+			}
+
+			entry := BundleVersion{Version: version, Platform: platform.Name(), SHA256: digest, Size: size}
+			manifest.Versions = append(manifest.Versions, entry)
+			files = append(files, exportFile{Name: path.Join(entry.dir(), utils.BinaryName), Source: binPath, Mode: 0755})
+			if meta != nil {
+				files = append(files, exportFile{
+					Name:   path.Join(entry.dir(), utils.MetaFileName),
+					Source: filepath.Join(versionsDir, version, utils.MetaFileName),
+					Mode:   0644,
+				})
+			}
+		}
+	}
+
+	aliased, err := aliasedVersions()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read aliases: %w", err)
+	}
+	for _, version := range versions {
+		for _, alias := range aliased[version] {
+			files = append(files, exportFile{Name: path.Join(utils.AliasesDir, alias), Source: filepath.Join(utils.JFCMAliases, alias), Mode: 0644})
+		}
+	}
+	if _, err := os.Stat(utils.JFCMBlockFile); err == nil {
+		files = append(files, exportFile{Name: utils.BlockFile, Source: utils.JFCMBlockFile, Mode: 0644})
+	}
+
+	cacheFiles, err := bundleCacheFiles()
+	if err != nil {
+		return nil, nil, err
+	}
+	files = append(files, cacheFiles...)
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	files = append(files, exportFile{Name: BundleManifestFile, Data: data, Mode: 0644})
+
+	sums, err := bundleChecksums(files)
+	if err != nil {
+		return nil, nil, err
+	}
+	files = append(files, exportFile{Name: BundleChecksumsFile, Data: sums, Mode: 0644})
+	return files, manifest, nil
+}
+
+// bundleCacheFiles lists the cached GitHub API responses
+func bundleCacheFiles() ([]exportFile, error) {
+	cacheDir := filepath.Join(utils.JFCMCache, GitHubCacheDir)
+	entries, err := os.ReadDir(cacheDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the changelog cache: %w", err)
+	}
+
+	var files []exportFile
+	for _, entry := range entries {
+		// Hidden files are in-progress cache writes
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		files = append(files, exportFile{
+			Name:   path.Join(utils.CacheDir, GitHubCacheDir, entry.Name()),
+			Source: filepath.Join(cacheDir, entry.Name()),
+			Mode:   0644,
+		})
+	}
+	return files, nil
+}
+
+// bundleChecksums returns a SHA256SUMS file covering files, readable by `sha256sum -c`
+func bundleChecksums(files []exportFile) ([]byte, error) {
+	var sums bytes.Buffer
+	for _, f := range files {
+		digest := ""
+		if f.Source != "" {
+			var err error
+			if digest, _, err = utils.HashFile(f.Source); err != nil {
+				return nil, err
+			}
+		} else {
+			sum := sha256.Sum256(f.Data)
+			digest = hex.EncodeToString(sum[:])
+		}
+		fmt.Fprintf(&sums, "%s  %s\n", digest, f.Name)
+	}
+	return sums.Bytes(), nil
+}
+
+// importBundle verifies a bundle and installs its contents
+func importBundle(bundlePath string, force bool) (*BundleImportReport, error) {
+	if err := os.MkdirAll(utils.JFCMRoot, 0755); err != nil {
+		return nil, err
+	}
+	// Extract next to the versions directory so builds can be renamed into place
+	staging, err := os.MkdirTemp(utils.JFCMRoot, ".bundle-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	if err := extractBundle(bundlePath, staging); err != nil {
+		return nil, fmt.Errorf("failed to extract %s: %w", bundlePath, err)
+	}
+	sums, err := verifyBundleChecksums(staging)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(staging, BundleManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("bundle has no manifest: %w", err)
+	}
+	var manifest BundleManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse the bundle manifest: %w", err)
+	}
+	if manifest.FormatVersion > bundleFormatVersion {
+		return nil, fmt.Errorf("bundle format %d was written by a newer jfcm; please upgrade jfcm", manifest.FormatVersion)
+	}
+
+	// The checksums only show the bundle is consistent, not who wrote it: never let the manifest
+	// point outside the versions directories
+	for _, entry := range manifest.Versions {
+		if err := validateBundleVersion(entry); err != nil {
+			return nil, fmt.Errorf("bundle manifest is invalid: %w", err)
+		}
+	}
+
+	blockList, err := readBundleBlockList(staging)
+	if err != nil {
+		return nil, fmt.Errorf("bundle block list is invalid: %w", err)
+	}
+
+	report := &BundleImportReport{}
+	for _, entry := range manifest.Versions {
+		if sums[path.Join(entry.dir(), utils.BinaryName)] != entry.SHA256 {
+			return nil, fmt.Errorf("bundle is corrupted: the %s binary of %s does not match the manifest", entry.Platform, entry.Version)
+		}
+		status, err := importBundledVersion(staging, entry, force)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import %s for %s: %w", entry.Version, entry.Platform, err)
+		}
+		report.Versions = append(report.Versions, BundleVersionResult{BundleVersion: entry, Status: status})
+	}
+
+	if report.Aliases, report.AliasConflicts, err = importBundleAliases(staging, force); err != nil {
+		return nil, err
+	}
+	if report.Blocked, err = importBundleBlockList(blockList); err != nil {
+		return nil, err
+	}
+	if report.CacheEntries, err = importBundleCache(staging); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// extractBundle unpacks the regular files of a bundle under dest, rejecting entries that would
+// escape it
+func extractBundle(bundlePath, dest string) error {
+	file, err := os.Open(bundlePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			continue
+		case tar.TypeReg:
+		default:
+			return fmt.Errorf("unexpected entry %s in bundle", header.Name)
+		}
+
+		name := path.Clean(header.Name)
+		if !fs.ValidPath(name) || name == "." {
+			return fmt.Errorf("invalid path %s in bundle", header.Name)
+		}
+		target := filepath.Join(dest, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(header.Mode).Perm())
+		if err != nil {
+			return err
+		}
+		if _, err := io.CopyN(out, tr, header.Size); err != nil {
+			out.Close()
+			return err
+		}
+		if err := out.Close(); err != nil {
+			return err
+		}
+	}
+}
+
+// verifyBundleChecksums checks every extracted file against SHA256SUMS and returns the digests by path
+func verifyBundleChecksums(dir string) (map[string]string, error) {
+	file, err := os.Open(filepath.Join(dir, BundleChecksumsFile))
+	if err != nil {
+		return nil, fmt.Errorf("bundle has no %s: %w", BundleChecksumsFile, err)
+	}
+	defer file.Close()
+
+	sums := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		digest, name, found := strings.Cut(scanner.Text(), "  ")
+		if !found {
+			return nil, fmt.Errorf("malformed %s line: %q", BundleChecksumsFile, scanner.Text())
+		}
+		sums[name] = digest
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if name == BundleChecksumsFile {
+			return nil
+		}
+		expected, listed := sums[name]
+		if !listed {
+			return fmt.Errorf("bundle is corrupted: %s is not listed in %s", name, BundleChecksumsFile)
+		}
+		digest, _, err := utils.HashFile(p)
+		if err != nil {
+			return err
+		}
+		if digest != expected {
+			return fmt.Errorf("bundle is corrupted: checksum mismatch for %s", name)
+		}
+		seen[name] = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for name := range sums {
+		if !seen[name] {
+			return nil, fmt.Errorf("bundle is corrupted: %s is missing", name)
+		}
+	}
+	return sums, nil
+}
+
+// validateBundleVersion checks that a manifest entry names a published platform and a semantic version
+func validateBundleVersion(entry BundleVersion) error {
+	platform, err := internal.ParsePlatform(entry.Platform)
+	if err != nil {
+		return err
+	}
+	if platform.Name() != entry.Platform {
+		return fmt.Errorf("invalid platform %q, expected %s", entry.Platform, platform.Name())
+	}
+	return utils.ValidateVersionName(entry.Version)
+}
+
+// importBundledVersion installs one build from the staging directory
+func importBundledVersion(staging string, entry BundleVersion, force bool) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), utils.StateLockTimeout)
+	defer cancel()
+	versionLock, _, err := utils.LockVersion(ctx, entry.Platform, entry.Version)
+	if err != nil {
+		return "", err
+	}
+	defer versionLock.Release()
+
+	versionsDir := utils.PlatformVersionsDir(entry.Platform)
+	binPath := filepath.Join(versionsDir, entry.Version, utils.BinaryName)
+	if rel, err := filepath.Rel(versionsDir, binPath); err != nil || !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("version %q resolves outside %s", entry.Version, versionsDir)
+	}
+	if digest, _, err := utils.HashFile(binPath); err == nil {
+		if digest == entry.SHA256 {
+			return BundleSkipped, nil
+		}
+		if !force {
+			return BundleConflict, nil
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(binPath), 0755); err != nil {
+		return "", err
+	}
+	if err := os.Rename(filepath.Join(staging, filepath.FromSlash(entry.dir()), utils.BinaryName), binPath); err != nil {
+		return "", err
+	}
+	if err := os.Chmod(binPath, 0755); err != nil {
+		return "", err
+	}
+
+	meta := utils.VersionMeta{Platform: entry.Platform, Installer: utils.InstallerImport}
+	if bundled, err := utils.LoadVersionMetaIn(filepath.Join(staging, utils.PlatformsDir, entry.Platform, utils.VersionsDir), entry.Version); err == nil {
+		meta.SourceURL = bundled.SourceURL
+	}
+	if _, err := utils.WriteVersionMetaIn(versionsDir, entry.Version, meta); err != nil {
+		return "", err
+	}
+	return BundleImported, nil
+}
+
+// importBundleAliases installs the bundled aliases. Aliases that exist with a different target are
+// only replaced with force.
+func importBundleAliases(staging string, force bool) (imported, conflicts []string, err error) {
+	entries, err := os.ReadDir(filepath.Join(staging, utils.AliasesDir))
+	if os.IsNotExist(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := os.MkdirAll(utils.JFCMAliases, 0755); err != nil {
+		return nil, nil, err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		data, err := os.ReadFile(filepath.Join(staging, utils.AliasesDir, name))
+		if err != nil {
+			return nil, nil, err
+		}
+		target := filepath.Join(utils.JFCMAliases, name)
+		if existing, err := os.ReadFile(target); err == nil {
+			if bytes.Equal(existing, data) {
+				continue
+			}
+			if !force {
+				conflicts = append(conflicts, name)
+				continue
+			}
+		}
+		if err := utils.WriteFileAtomic(target, data, 0644); err != nil {
+			return nil, nil, err
+		}
+		imported = append(imported, name)
+	}
+	return imported, conflicts, nil
+}
+
+// readBundleBlockList reads the bundled blocked versions, checking each line the way 'jfcm block'
+// checks its argument
+func readBundleBlockList(staging string) ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(staging, utils.BlockFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []string
+	for i, line := range strings.Split(string(data), "\n") {
+		version := strings.TrimSpace(line)
+		if version == "" {
+			continue
+		}
+		if _, err := utils.ParseVersion(version); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// importBundleBlockList adds the bundled blocked versions to the local block list
+func importBundleBlockList(versions []string) ([]string, error) {
+	var added []string
+	for _, version := range versions {
+		blocked, err := utils.IsVersionBlocked(version)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			continue
+		}
+		if err := utils.BlockVersion(version); err != nil {
+			return nil, err
+		}
+		added = append(added, version)
+	}
+	return added, nil
+}
+
+// importBundleCache copies bundled GitHub API responses the local cache does not have yet
+func importBundleCache(staging string) (int, error) {
+	source := filepath.Join(staging, utils.CacheDir, GitHubCacheDir)
+	entries, err := os.ReadDir(source)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	cacheDir := filepath.Join(utils.JFCMCache, GitHubCacheDir)
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return 0, err
+	}
+	copied := 0
+	for _, entry := range entries {
+		target := filepath.Join(cacheDir, entry.Name())
+		if _, err := os.Stat(target); err == nil {
+			continue
+		}
+		if err := utils.CopyFile(filepath.Join(source, entry.Name()), target); err != nil {
+			return copied, err
+		}
+		copied++
+	}
+	return copied, nil
+}
+
+// displayBundleImport prints the outcome of an import
+func displayBundleImport(report *BundleImportReport) {
+	fmt.Println("📋 Bundle import")
+	// Auto formatting would mangle version numbers
+	table := tablewriter.NewTable(os.Stdout, tablewriter.WithHeaderAutoFormat(tw.Off))
+	table.Header("VERSION", "PLATFORM", "STATUS")
+	for _, result := range report.Versions {
+		status := "⏭️  " + result.Status
+		switch result.Status {
+		case BundleImported:
+			status = "✅ " + result.Status
+		case BundleConflict:
+			status = "⚠️  differs from the bundle"
+		}
+		_ = table.Append(result.Version, result.Platform, status)
+	}
+	_ = table.Render()
+
+	if len(report.Aliases) > 0 {
+		fmt.Printf("🏷️  Imported aliases: %s\n", strings.Join(report.Aliases, ", "))
+	}
+	if len(report.AliasConflicts) > 0 {
+		fmt.Printf("⚠️  Aliases that differ from the bundle: %s\n", strings.Join(report.AliasConflicts, ", "))
+	}
+	if len(report.Blocked) > 0 {
+		fmt.Printf("🚫 Blocked versions added: %s\n", strings.Join(report.Blocked, ", "))
+	}
+	if report.CacheEntries > 0 {
+		fmt.Printf("📖 Added %d changelog cache entries for 'compare changelog --offline'\n", report.CacheEntries)
+	}
+}
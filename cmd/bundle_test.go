@@ -0,0 +1,275 @@
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+	"github.com/jfrog/jfrog-cli-manager/internal"
+)
+
+// useJFCMRoot points every jfcm state path at root until the test ends
+func useJFCMRoot(t *testing.T, root string) {
+	t.Helper()
+	old := []string{utils.JFCMRoot, utils.JFCMVersions, utils.JFCMAliases, utils.JFCMBlockFile, utils.JFCMCache, utils.JFCMLocks}
+	utils.JFCMRoot = root
+	utils.JFCMVersions = filepath.Join(root, utils.VersionsDir)
+	utils.JFCMAliases = filepath.Join(root, utils.AliasesDir)
+	utils.JFCMBlockFile = filepath.Join(root, utils.BlockFile)
+	utils.JFCMCache = filepath.Join(root, utils.CacheDir)
+	utils.JFCMLocks = filepath.Join(root, utils.LocksDir)
+	t.Cleanup(func() {
+		utils.JFCMRoot, utils.JFCMVersions, utils.JFCMAliases = old[0], old[1], old[2]
+		utils.JFCMBlockFile, utils.JFCMCache, utils.JFCMLocks = old[3], old[4], old[5]
+	})
+}
+
+// writeBundleFixture creates a bundle holding 2.50.0 and 2.51.0 for the host and for s390x
+func writeBundleFixture(t *testing.T) string {
+	t.Helper()
+	useJFCMRoot(t, t.TempDir())
+
+	host, err := installPlatform("")
+	if err != nil {
+		t.Skip(err)
+	}
+	platforms := []internal.Platform{host}
+	if other, _ := internal.LookupPlatform("linux", "s390x"); other != host {
+		platforms = append(platforms, other)
+	}
+	for _, platform := range platforms {
+		versionsDir := utils.PlatformVersionsDir(platform.Name())
+		for _, version := range []string{"2.50.0", "2.51.0"} {
+			if err := os.MkdirAll(filepath.Join(versionsDir, version), 0755); err != nil {
+				t.Fatal(err)
+			}
+			binPath := filepath.Join(versionsDir, version, utils.BinaryName)
+			if err := os.WriteFile(binPath, []byte("jf "+version+" "+platform.Name()), 0755); err != nil {
+				t.Fatal(err)
+			}
+			meta := utils.VersionMeta{SourceURL: platform.URL("https://releases.jfrog.io/artifactory", version), Platform: platform.Name(), Installer: utils.InstallerDownload}
+			if _, err := utils.WriteVersionMetaIn(versionsDir, version, meta); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := os.MkdirAll(utils.JFCMAliases, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(utils.JFCMAliases, "prod"), []byte(`{"version":"2.51.0"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := utils.BlockVersion("2.49.0"); err != nil {
+		t.Fatal(err)
+	}
+	cacheDir := filepath.Join(utils.JFCMCache, GitHubCacheDir)
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(cacheDir, "releases.json"), []byte("[]"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	files, manifest, err := planBundle([]string{"2.50.0", "2.51.0"}, platforms)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(manifest.Versions) != 2*len(platforms) {
+		t.Fatalf("expected %d builds in the manifest, got %v", 2*len(platforms), manifest.Versions)
+	}
+	output := filepath.Join(t.TempDir(), "jfcm-bundle.tar.gz")
+	if err := writeExportTarball(output, files); err != nil {
+		t.Fatal(err)
+	}
+	return output
+}
+
+func TestBundleRoundTrip(t *testing.T) {
+	bundle := writeBundleFixture(t)
+	entries := readTarball(t, bundle)
+	for _, name := range []string{BundleManifestFile, BundleChecksumsFile, "aliases/prod", "blocked-versions", "cache/github/releases.json"} {
+		if _, ok := entries[name]; !ok {
+			t.Errorf("expected %s in the bundle", name)
+		}
+	}
+
+	// Import on a machine that already blocks another version
+	useJFCMRoot(t, t.TempDir())
+	if err := utils.BlockVersion("2.10.0"); err != nil {
+		t.Fatal(err)
+	}
+	report, err := importBundle(bundle, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, result := range report.Versions {
+		if result.Status != BundleImported {
+			t.Errorf("expected %s for %s to be imported, got %s", result.Version, result.Platform, result.Status)
+		}
+		versionsDir := utils.PlatformVersionsDir(result.Platform)
+		content, err := os.ReadFile(filepath.Join(versionsDir, result.Version, utils.BinaryName))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != "jf "+result.Version+" "+result.Platform {
+			t.Errorf("unexpected binary content %q", content)
+		}
+		meta, err := utils.LoadVersionMetaIn(versionsDir, result.Version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if meta.Installer != utils.InstallerImport || meta.SHA256 != result.SHA256 || !strings.Contains(meta.SourceURL, result.Version) {
+			t.Errorf("unexpected metadata %+v", meta)
+		}
+	}
+	if version, err := utils.ResolveAlias("prod"); err != nil || version != "2.51.0" {
+		t.Errorf("expected alias prod -> 2.51.0, got %q (%v)", version, err)
+	}
+	blocked, err := utils.GetBlockedVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"2.10.0", "2.49.0"}; !reflect.DeepEqual(blocked, expected) {
+		t.Errorf("expected blocked versions %v, got %v", expected, blocked)
+	}
+	if report.CacheEntries != 1 {
+		t.Errorf("expected 1 cache entry, got %d", report.CacheEntries)
+	}
+
+	// A second import skips identical builds and reports builds that differ
+	binPath := filepath.Join(utils.JFCMVersions, "2.50.0", utils.BinaryName)
+	if err := os.WriteFile(binPath, []byte("patched"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if report, err = importBundle(bundle, false); err != nil {
+		t.Fatal(err)
+	}
+	statuses := make(map[string]string)
+	for _, result := range report.Versions {
+		if result.Platform == utils.HostPlatform() {
+			statuses[result.Version] = result.Status
+		}
+	}
+	if expected := map[string]string{"2.50.0": BundleConflict, "2.51.0": BundleSkipped}; !reflect.DeepEqual(statuses, expected) {
+		t.Errorf("expected %v, got %v", expected, statuses)
+	}
+	if report.Conflicts() != 1 {
+		t.Errorf("expected 1 conflict, got %d", report.Conflicts())
+	}
+
+	if _, err = importBundle(bundle, true); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := os.ReadFile(binPath); string(content) != "jf 2.50.0 "+utils.HostPlatform() {
+		t.Errorf("expected --force to restore the bundled binary, got %q", content)
+	}
+}
+
+func TestBundleImportRejectsTampering(t *testing.T) {
+	bundle := writeBundleFixture(t)
+	entries := readTarball(t, bundle)
+
+	hostBinary := "platforms/" + utils.HostPlatform() + "/versions/2.50.0/jf"
+	for name, tc := range map[string]struct {
+		tamper  func(map[string]tarEntry)
+		message string
+	}{
+		"modified binary": {func(e map[string]tarEntry) { e[hostBinary] = tarEntry{mode: 0755, content: "evil"} }, "corrupted"},
+		"unlisted file":   {func(e map[string]tarEntry) { e["aliases/extra"] = tarEntry{mode: 0644, content: "{}"} }, "corrupted"},
+		"missing file":    {func(e map[string]tarEntry) { delete(e, "aliases/prod") }, "corrupted"},
+		// A consistent bundle whose manifest points outside the versions directory
+		"version escaping the versions directory": {func(e map[string]tarEntry) {
+			e["shim/jf"] = tarEntry{mode: 0755, content: "evil"}
+			sum := sha256.Sum256([]byte("evil"))
+			manifest, _ := json.Marshal(BundleManifest{FormatVersion: bundleFormatVersion, Versions: []BundleVersion{
+				{Version: "../../../shim", Platform: "linux-s390x", SHA256: hex.EncodeToString(sum[:]), Size: 4},
+			}})
+			e[BundleManifestFile] = tarEntry{mode: 0644, content: string(manifest)}
+			resealBundle(e)
+		}, "invalid version"},
+		"invalid block list entry": {func(e map[string]tarEntry) {
+			e[utils.BlockFile] = tarEntry{mode: 0644, content: "2.10.0\nnot-a-version\n"}
+			resealBundle(e)
+		}, "line 2: invalid version format"},
+		"unknown platform": {func(e map[string]tarEntry) {
+			manifest, _ := json.Marshal(BundleManifest{FormatVersion: bundleFormatVersion, Versions: []BundleVersion{
+				{Version: "2.50.0", Platform: "../../x"},
+			}})
+			e[BundleManifestFile] = tarEntry{mode: 0644, content: string(manifest)}
+			resealBundle(e)
+		}, "unsupported platform"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tampered := make(map[string]tarEntry)
+			for k, v := range entries {
+				tampered[k] = v
+			}
+			tc.tamper(tampered)
+			path := filepath.Join(t.TempDir(), "tampered.tar.gz")
+			writeTarballEntries(t, path, tampered)
+
+			useJFCMRoot(t, t.TempDir())
+			if _, err := importBundle(path, false); err == nil || !strings.Contains(err.Error(), tc.message) {
+				t.Fatalf("expected an error mentioning %q, got %v", tc.message, err)
+			}
+			if blocked, _ := utils.GetBlockedVersions(); len(blocked) != 0 {
+				t.Errorf("expected nothing to be blocked from a tampered bundle, got %v", blocked)
+			}
+			for _, dir := range []string{filepath.Join(utils.JFCMVersions, "2.50.0"), filepath.Join(utils.JFCMRoot, "shim")} {
+				if _, err := os.Stat(dir); !os.IsNotExist(err) {
+					t.Errorf("expected nothing to be installed from a tampered bundle, found %s", dir)
+				}
+			}
+		})
+	}
+}
+
+// resealBundle rewrites SHA256SUMS to match the other entries, as a malicious bundle author would
+func resealBundle(entries map[string]tarEntry) {
+	var sums strings.Builder
+	for name, entry := range entries {
+		if name == BundleChecksumsFile {
+			continue
+		}
+		sum := sha256.Sum256([]byte(entry.content))
+		fmt.Fprintf(&sums, "%s  %s\n", hex.EncodeToString(sum[:]), name)
+	}
+	entries[BundleChecksumsFile] = tarEntry{mode: 0644, content: sums.String()}
+}
+
+// writeTarballEntries writes entries to a gzipped tarball
+func writeTarballEntries(t *testing.T, path string, entries map[string]tarEntry) {
+	t.Helper()
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	gz := gzip.NewWriter(file)
+	tw := tar.NewWriter(gz)
+	for name, entry := range entries {
+		header := &tar.Header{Name: name, Mode: entry.mode, Size: int64(len(entry.content)), ModTime: time.Now(), Typeflag: tar.TypeReg}
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(entry.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -4,21 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"sort"
 	"strings"
 	"time"
-
-	"golang.org/x/sync/errgroup"
 )
 
 // Constants for configuration
 const (
-	DefaultPerPage = 30               // GitHub API default page size
-	DefaultTimeout = 30 * time.Second // Reasonable timeout for API calls
-	UserAgent      = "jfcm/1.0"       // Identify our tool to GitHub
-	MaxConcurrent  = 5                // Max concurrent requests
+	DefaultPerPage  = 30               // GitHub API default page size
+	ReleasesPerPage = 100              // GitHub API maximum page size, keeps full-range walks short
+	DefaultTimeout  = 30 * time.Second // Reasonable timeout for API calls
+	UserAgent       = "jfcm/1.0"       // Identify our tool to GitHub
 )
 
 // Shared HTTP client for better performance
@@ -39,23 +36,23 @@ type noteResult struct {
 	Name string
 	Body string
 	Time time.Time
-	Err  error
 }
 
-// returns up to 5 release notes between two tags (exclusive lower bound, inclusive upper),
-// ordered by published_at ascending.
-func FetchTopReleasesNotes(ctx context.Context, owner, repo, fromTag, toTag string) ([]noteResult, error) {
+// FetchReleaseNotes returns every published release between two tags (exclusive lower bound,
+// inclusive upper), ordered by published_at ascending. Drafts and prereleases inside the range are
+// skipped; the upper boundary release is always included since it was explicitly requested.
+func FetchReleaseNotes(ctx context.Context, client *GitHubClient, owner, repo, fromTag, toTag string) ([]noteResult, error) {
 	// Input validation
 	if owner == "" || repo == "" || fromTag == "" || toTag == "" {
 		return nil, fmt.Errorf("owner, repo, fromTag, and toTag cannot be empty")
 	}
 
 	// Resolve boundary releases to get their published_at and canonical tag names
-	fromRel, err := getReleaseByTag(ctx, owner, repo, fromTag)
+	fromRel, err := getReleaseByTag(ctx, client, owner, repo, fromTag)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching fromTag %s: %w", fromTag, err)
 	}
-	toRel, err := getReleaseByTag(ctx, owner, repo, toTag)
+	toRel, err := getReleaseByTag(ctx, client, owner, repo, toTag)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching toTag %s: %w", toTag, err)
 	}
@@ -70,7 +67,7 @@ func FetchTopReleasesNotes(ctx context.Context, owner, repo, fromTag, toTag stri
 	}
 
 	// Find last page of releases via Link header
-	lastPage, err := getLastPageReleases(ctx, owner, repo, DefaultPerPage)
+	lastPage, err := getLastPageReleases(ctx, client, owner, repo, ReleasesPerPage)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +76,7 @@ func FetchTopReleasesNotes(ctx context.Context, owner, repo, fromTag, toTag stri
 	}
 
 	// Binary search the page that contains maxDate between its first and last items
-	startPage, err := findPageForDateReleases(ctx, owner, repo, DefaultPerPage, lastPage, maxDate)
+	startPage, err := findPageForDateReleases(ctx, client, owner, repo, ReleasesPerPage, lastPage, maxDate)
 	if err != nil {
 		return nil, err
 	}
@@ -87,13 +84,11 @@ func FetchTopReleasesNotes(ctx context.Context, owner, repo, fromTag, toTag stri
 		startPage = 1
 	}
 
-	// Collect tag names up to 5 from startPage forward (older pages) while within (minDate, maxDate]
-	tags := make([]string, 0, 5)
-	// Ensure upper tag is included first
-	tags = append(tags, upper.TagName)
+	notes := []noteResult{{Tag: upper.TagName, Name: upper.Name, Body: upper.Body, Time: upper.PublishedAt}}
 
-	for page := startPage; len(tags) < 5 && page <= lastPage; page++ {
-		pageReleases, err := listReleasesPage(ctx, owner, repo, page, DefaultPerPage)
+	// Walk pages towards older releases until the lower bound is passed
+	for page := startPage; page <= lastPage; page++ {
+		pageReleases, err := listReleasesPage(ctx, client, owner, repo, page, ReleasesPerPage)
 		if err != nil {
 			return nil, err
 		}
@@ -101,7 +96,7 @@ func FetchTopReleasesNotes(ctx context.Context, owner, repo, fromTag, toTag stri
 			break
 		}
 		for _, r := range pageReleases {
-			if r.TagName == "" {
+			if r.TagName == "" || r.Draft || r.Prerelease {
 				continue
 			}
 			// in window: published_at > minDate and <= maxDate
@@ -112,183 +107,98 @@ func FetchTopReleasesNotes(ctx context.Context, owner, repo, fromTag, toTag stri
 			if r.TagName == upper.TagName {
 				continue
 			}
-			tags = append(tags, r.TagName)
-			if len(tags) >= 5 {
-				break
-			}
+			notes = append(notes, noteResult{Tag: r.TagName, Name: r.Name, Body: r.Body, Time: r.PublishedAt})
 		}
-		// Early stop if oldest on this page is <= minDate
-		if pageReleases[len(pageReleases)-1].PublishedAt.Before(minDate) || pageReleases[len(pageReleases)-1].PublishedAt.Equal(minDate) {
+		// Stop once the oldest release on this page is at or before the lower bound
+		if !pageReleases[len(pageReleases)-1].PublishedAt.After(minDate) {
 			break
 		}
 	}
 
-	if len(tags) == 0 {
-		return nil, fmt.Errorf("no releases found in date range for %s/%s", owner, repo)
-	}
+	sort.Slice(notes, func(i, j int) bool {
+		return notes[i].Time.Before(notes[j].Time)
+	})
 
-	// Fetch release notes by tag concurrently for the collected tags
-	g, gctx := errgroup.WithContext(ctx)
-	g.SetLimit(MaxConcurrent)
-	results := make([]noteResult, len(tags))
-	for i := range tags {
-		tag := tags[i]
-		g.Go(func() error {
-			rel, err := getReleaseByTag(gctx, owner, repo, tag)
-			if err != nil {
-				results[i] = noteResult{Tag: tag, Err: err}
-				return nil // Continue with other fetches even if this one fails
-			}
-			results[i] = noteResult{Tag: rel.TagName, Name: rel.Name, Body: rel.Body, Time: rel.PublishedAt}
-			return nil
-		})
-	}
-	g.Wait() // Collect all results, ignore errors since we handle them individually
+	return notes, nil
+}
 
-	// Filter successful results and sort by published time ascending
-	var successful []noteResult
-	for _, r := range results {
-		if r.Err == nil {
-			successful = append(successful, r)
+// selectReleaseNotes applies --limit (keeping the newest releases) and --reverse (newest first)
+// to notes ordered by published_at ascending
+func selectReleaseNotes(notes []noteResult, limit int, reverse bool) []noteResult {
+	selected := notes
+	if limit > 0 && len(selected) > limit {
+		selected = selected[len(selected)-limit:]
+	}
+	if reverse {
+		reversed := make([]noteResult, len(selected))
+		for i, note := range selected {
+			reversed[len(selected)-1-i] = note
 		}
+		selected = reversed
 	}
-
-	if len(successful) == 0 {
-		return nil, fmt.Errorf("failed to fetch any release notes for tags in range")
-	}
-
-	sort.Slice(successful, func(i, j int) bool {
-		return successful[i].Time.Before(successful[j].Time)
-	})
-
-	return successful, nil
+	return selected
 }
 
 // tag should have v appended in the string by the user
-func getReleaseByTag(ctx context.Context, owner, repo, tag string) (GitHubRelease, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("https://api.github.com/repos/%s/%s/releases/tags/%s", owner, repo, tag), nil)
-	if err != nil {
-		return GitHubRelease{}, fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("User-Agent", UserAgent)
-	req.Header.Set("Accept", "application/vnd.github.v3+json")
-
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		return GitHubRelease{}, fmt.Errorf("failed to fetch release by tag: %w", err)
-	}
-	defer resp.Body.Close()
-	// Read response body once for all cases
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return GitHubRelease{}, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
+func getReleaseByTag(ctx context.Context, client *GitHubClient, owner, repo, tag string) (GitHubRelease, error) {
+	resp, err := client.get(ctx, fmt.Sprintf("/repos/%s/%s/releases/tags/%s", owner, repo, tag))
+	if isGitHubNotFound(err) {
 		// try with v-prefix if not already present
 		if !strings.HasPrefix(tag, "v") {
-			return getReleaseByTag(ctx, owner, repo, "v"+tag)
+			return getReleaseByTag(ctx, client, owner, repo, "v"+tag)
 		}
 		return GitHubRelease{}, fmt.Errorf("release not found for tag %s", tag)
 	}
-	if resp.StatusCode == http.StatusForbidden {
-		if strings.Contains(string(body), "rate limit") {
-			return GitHubRelease{}, fmt.Errorf("GitHub API rate limit exceeded. Please wait and try again, or authenticate your requests")
-		}
-		return GitHubRelease{}, fmt.Errorf("GitHub API access forbidden: %s", string(body))
-	}
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return GitHubRelease{}, fmt.Errorf("GitHub API error %d: %s", resp.StatusCode, string(body))
+	if err != nil {
+		return GitHubRelease{}, fmt.Errorf("failed to fetch release by tag: %w", err)
 	}
+
 	var rel GitHubRelease
-	if err := json.Unmarshal(body, &rel); err != nil {
+	if err := json.Unmarshal(resp.Body, &rel); err != nil {
 		return GitHubRelease{}, fmt.Errorf("failed to parse release: %w", err)
 	}
 	return rel, nil
 }
 
-func listReleasesPage(ctx context.Context, owner, repo string, page, perPage int) ([]GitHubRelease, error) {
+func listReleasesPage(ctx context.Context, client *GitHubClient, owner, repo string, page, perPage int) ([]GitHubRelease, error) {
 	if perPage <= 0 {
 		perPage = DefaultPerPage
 	}
-	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("https://api.github.com/repos/%s/%s/releases?per_page=%d&page=%d", owner, repo, perPage, page), nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("User-Agent", UserAgent)
-	req.Header.Set("Accept", "application/vnd.github.v3+json")
-	resp, err := httpClient.Do(req)
+	resp, err := client.get(ctx, fmt.Sprintf("/repos/%s/%s/releases?per_page=%d&page=%d", owner, repo, perPage, page))
 	if err != nil {
 		return nil, fmt.Errorf("failed to list releases: %w", err)
 	}
-	defer resp.Body.Close()
-
-	// Read response body for error handling
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	if resp.StatusCode == http.StatusForbidden {
-		if strings.Contains(string(body), "rate limit") {
-			return nil, fmt.Errorf("GitHub API rate limit exceeded. Please wait and try again, or authenticate your requests")
-		}
-		return nil, fmt.Errorf("GitHub API access forbidden: %s", string(body))
-	}
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("GitHub API error %d: %s", resp.StatusCode, string(body))
-	}
 
 	var rels []GitHubRelease
-	if err := json.Unmarshal(body, &rels); err != nil {
+	if err := json.Unmarshal(resp.Body, &rels); err != nil {
 		return nil, fmt.Errorf("failed to parse releases: %w", err)
 	}
 	return rels, nil
 }
 
-func getLastPageReleases(ctx context.Context, owner, repo string, perPage int) (int, error) {
+func getLastPageReleases(ctx context.Context, client *GitHubClient, owner, repo string, perPage int) (int, error) {
 	if perPage <= 0 {
 		perPage = DefaultPerPage
 	}
-	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("https://api.github.com/repos/%s/%s/releases?per_page=%d&page=1", owner, repo, perPage), nil)
-	if err != nil {
-		return 0, fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("User-Agent", UserAgent)
-	req.Header.Set("Accept", "application/vnd.github.v3+json")
-	resp, err := httpClient.Do(req)
+	resp, err := client.get(ctx, fmt.Sprintf("/repos/%s/%s/releases?per_page=%d&page=1", owner, repo, perPage))
 	if err != nil {
 		return 0, fmt.Errorf("failed to fetch first releases page: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusForbidden {
-		body, _ := io.ReadAll(resp.Body)
-		if strings.Contains(string(body), "rate limit") {
-			return 0, fmt.Errorf("GitHub API rate limit exceeded. Please wait and try again, or authenticate your requests")
-		}
-		return 0, fmt.Errorf("GitHub API access forbidden: %s", string(body))
-	}
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		body, _ := io.ReadAll(resp.Body)
-		return 0, fmt.Errorf("GitHub API error %d: %s", resp.StatusCode, string(body))
-	}
-	link := resp.Header.Get("Link")
-	if link == "" {
+	if resp.Link == "" {
 		return 1, nil
 	}
-	last := parseLastPageFromLink(link)
+	last := parseLastPageFromLink(resp.Link)
 	if last == 0 {
 		return 1, nil
 	}
 	return last, nil
 }
 
-func findPageForDateReleases(ctx context.Context, owner, repo string, perPage, lastPage int, target time.Time) (int, error) {
+func findPageForDateReleases(ctx context.Context, client *GitHubClient, owner, repo string, perPage, lastPage int, target time.Time) (int, error) {
 	lo, hi := 1, lastPage
 	for lo <= hi {
 		mid := lo + (hi-lo)/2
-		items, err := listReleasesPage(ctx, owner, repo, mid, perPage)
+		items, err := listReleasesPage(ctx, client, owner, repo, mid, perPage)
 		if err != nil {
 			return 0, err
 		}
@@ -481,7 +391,7 @@ func DisplayChangelogResults(releaseNotes []noteResult, version1, version2 strin
 	fmt.Printf("\n\n")
 	fmt.Printf("╔══════════════════════════════════════════════════════════════════════════════════════╗\n")
 
-	fmt.Printf("║ ✅ Summary: Displaying only recent %d release(s) between %s → %s\n",
+	fmt.Printf("║ ✅ Summary: Displaying %d release(s) between %s → %s\n",
 		len(releaseNotes),
 		colors.Blue.Sprint(version1),
 		colors.Blue.Sprint(version2))
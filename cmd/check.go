@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+	"github.com/jfrog/jfrog-cli-manager/internal"
 	"github.com/urfave/cli/v2"
 )
 
@@ -28,6 +30,11 @@ var HealthCheck = &cli.Command{
 			Aliases: []string{"f"},
 			Usage:   "Attempt to fix detected issues automatically",
 		},
+		&cli.BoolFlag{
+			Name:    "yes",
+			Aliases: []string{"y"},
+			Usage:   "Apply fixes that change the shell profile without asking",
+		},
 		&cli.BoolFlag{
 			Name:    "json",
 			Aliases: []string{"j"},
@@ -43,24 +50,91 @@ var HealthCheck = &cli.Command{
 			Aliases: []string{"s"},
 			Usage:   "Include security checks",
 		},
+		&cli.StringFlag{
+			Name:  "only",
+			Usage: "Run only these check categories (comma-separated)",
+		},
+		&cli.StringFlag{
+			Name:  "skip",
+			Usage: "Skip these check categories (comma-separated)",
+		},
+		&cli.StringFlag{
+			Name:  "fail-on",
+			Usage: "Exit with status 1 when checks in these categories fail (comma-separated, or 'all')",
+		},
+		&cli.BoolFlag{
+			Name:  "strict",
+			Usage: "Treat warnings as failures for --fail-on",
+		},
 	},
 	Action: func(c *cli.Context) error {
-		verbose := c.Bool("verbose")
-		fix := c.Bool("fix")
-		json := c.Bool("json")
-		performance := c.Bool("performance")
-		security := c.Bool("security")
-
-		if json {
-			return runHealthCheckJSON(verbose, fix, performance, security)
+		options, err := extractHealthCheckOptions(c)
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
 		}
 
-		return runHealthCheck(verbose, fix, performance, security)
+		var report *HealthReport
+		if c.Bool("json") {
+			report, err = runHealthCheckJSON(options)
+		} else {
+			report, err = runHealthCheck(options)
+		}
+		if err != nil {
+			return err
+		}
+
+		statuses := report.Checks
+		if len(options.FailOn) > 0 && anyFixed(report.Fixes) {
+			// Judge the exit status on the repaired installation
+			statuses = newHealthReport(runChecks(selectChecks(healthChecks, options.Selection), CheckOptions{}, nil)).Checks
+		}
+		if failed := failedCategories(statuses, options.FailOn, options.Strict); len(failed) > 0 {
+			return cli.Exit(fmt.Sprintf("❌ Health check failed for: %s", strings.Join(failed, ", ")), 1)
+		}
+		return nil
 	},
 }
 
+// HealthCheckOptions holds the parsed health-check flags
+type HealthCheckOptions struct {
+	Verbose   bool
+	Fix       bool
+	Yes       bool
+	Strict    bool
+	Selection CheckSelection
+	FailOn    map[string]bool
+}
+
+func extractHealthCheckOptions(c *cli.Context) (HealthCheckOptions, error) {
+	options := HealthCheckOptions{
+		Verbose: c.Bool("verbose"),
+		Fix:     c.Bool("fix"),
+		Yes:     c.Bool("yes"),
+		Strict:  c.Bool("strict"),
+		Selection: CheckSelection{
+			Optional: map[string]bool{
+				CheckCategoryPerformance: c.Bool("performance"),
+				CheckCategorySecurity:    c.Bool("security"),
+			},
+		},
+	}
+
+	var err error
+	if options.Selection.Only, err = parseCheckCategories("only", c.String("only")); err != nil {
+		return options, err
+	}
+	if options.Selection.Skip, err = parseCheckCategories("skip", c.String("skip")); err != nil {
+		return options, err
+	}
+	if options.FailOn, err = parseCheckCategories("fail-on", c.String("fail-on")); err != nil {
+		return options, err
+	}
+	return options, nil
+}
+
 type HealthStatus struct {
 	Status    string `json:"status"`
+	Category  string `json:"category"`
 	Component string `json:"component"`
 	Message   string `json:"message"`
 	Details   string `json:"details,omitempty"`
@@ -74,13 +148,11 @@ type HealthReport struct {
 	Overall      string         `json:"overall"`
 	Checks       []HealthStatus `json:"checks"`
 	Summary      map[string]int `json:"summary"`
+	Fixes        []FixResult    `json:"fixes,omitempty"`
 }
 
-func runHealthCheck(verbose, fix, performance, security bool) error {
-	fmt.Println("🏥 jfcm Health Check")
-	fmt.Println("===================")
-	fmt.Println()
-
+// newHealthReport builds a report from the outcomes of the checks
+func newHealthReport(outcomes []checkOutcome) *HealthReport {
 	report := &HealthReport{
 		Timestamp:    time.Now(),
 		Platform:     runtime.GOOS,
@@ -88,80 +160,38 @@ func runHealthCheck(verbose, fix, performance, security bool) error {
 		Checks:       []HealthStatus{},
 		Summary:      map[string]int{"pass": 0, "fail": 0, "warn": 0},
 	}
+	for _, outcome := range outcomes {
+		for _, status := range outcome.Statuses {
+			report.Checks = append(report.Checks, status)
+			report.Summary[status.Status]++
+		}
+	}
 
-	// 1. System Environment Check
-	fmt.Println("1. 🔧 System Environment")
-	startCount := len(report.Checks)
-	checkSystemEnvironment(report, verbose)
-	printHealthResults(report.Checks[startCount:], verbose)
-	fmt.Println()
-
-	// 2. jfcm Installation Check
-	fmt.Println("2. 📦 jfcm Installation")
-	startCount = len(report.Checks)
-	checkjfcmInstallation(report, verbose)
-	printHealthResults(report.Checks[startCount:], verbose)
-	fmt.Println()
-
-	// 3. Shim Setup Check
-	fmt.Println("3. 🔗 Shim Setup")
-	startCount = len(report.Checks)
-	checkShimSetup(report, verbose, fix)
-	printHealthResults(report.Checks[startCount:], verbose)
-	fmt.Println()
-
-	// 4. PATH Priority Check
-	fmt.Println("4. 🎯 PATH Priority")
-	startCount = len(report.Checks)
-	checkPathPriority(report, verbose, fix)
-	printHealthResults(report.Checks[startCount:], verbose)
-	fmt.Println()
-
-	// 4.5. Shell Profile Corruption Check
-	fmt.Println("4.5. 🧹 Shell Profile Integrity")
-	startCount = len(report.Checks)
-	checkShellProfileIntegrity(report, verbose, fix)
-	printHealthResults(report.Checks[startCount:], verbose)
-	fmt.Println()
-
-	// 5. Active Version Check
-	fmt.Println("5. 📋 Active Version")
-	startCount = len(report.Checks)
-	checkActiveVersion(report, verbose)
-	printHealthResults(report.Checks[startCount:], verbose)
-	fmt.Println()
-
-	// 6. Binary Execution Check
-	fmt.Println("6. ⚡ Binary Execution")
-	startCount = len(report.Checks)
-	checkBinaryExecution(report, verbose)
-	printHealthResults(report.Checks[startCount:], verbose)
-	fmt.Println()
+	switch {
+	case report.Summary["fail"] > 0:
+		report.Overall = "FAILED"
+	case report.Summary["warn"] > 0:
+		report.Overall = "WARNING"
+	default:
+		report.Overall = "HEALTHY"
+	}
+	return report
+}
 
-	// 7. Network Connectivity Check
-	fmt.Println("7. 🌐 Network Connectivity")
-	startCount = len(report.Checks)
-	checkNetworkConnectivity(report, verbose)
-	printHealthResults(report.Checks[startCount:], verbose)
+func runHealthCheck(options HealthCheckOptions) (*HealthReport, error) {
+	fmt.Println("🏥 jfcm Health Check")
+	fmt.Println("===================")
 	fmt.Println()
 
-	// 8. Performance Check (optional)
-	if performance {
-		fmt.Println("8. 🚀 Performance")
-		startCount = len(report.Checks)
-		checkPerformance(report, verbose)
-		printHealthResults(report.Checks[startCount:], verbose)
+	checks := selectChecks(healthChecks, options.Selection)
+	step := 0
+	outcomes := runChecks(checks, CheckOptions{Verbose: options.Verbose, Debug: options.Verbose}, func(check Check, statuses []HealthStatus) {
+		step++
+		fmt.Printf("%d. %s\n", step, checkTitle(check))
+		printHealthResults(statuses, options.Verbose)
 		fmt.Println()
-	}
-
-	// 9. Security Check (optional)
-	if security {
-		fmt.Println("9. 🔒 Security")
-		startCount = len(report.Checks)
-		checkSecurity(report, verbose)
-		printHealthResults(report.Checks[startCount:], verbose)
-		fmt.Println()
-	}
+	})
+	report := newHealthReport(outcomes)
 
 	// Summary
 	fmt.Println("📊 Health Check Summary")
@@ -170,22 +200,24 @@ func runHealthCheck(verbose, fix, performance, security bool) error {
 	fmt.Printf("❌ Failed: %d\n", report.Summary["fail"])
 	fmt.Printf("⚠️  Warnings: %d\n", report.Summary["warn"])
 
-	// Determine overall status
-	if report.Summary["fail"] > 0 {
-		report.Overall = "FAILED"
+	switch report.Overall {
+	case "FAILED":
 		fmt.Printf("\n❌ Overall Status: FAILED - %d critical issues found\n", report.Summary["fail"])
-	} else if report.Summary["warn"] > 0 {
-		report.Overall = "WARNING"
+	case "WARNING":
 		fmt.Printf("\n⚠️  Overall Status: WARNING - %d non-critical issues found\n", report.Summary["warn"])
-	} else {
-		report.Overall = "HEALTHY"
+	default:
 		fmt.Printf("\n✅ Overall Status: HEALTHY - All checks passed\n")
 	}
 
-	if fix && report.Summary["fail"] > 0 {
+	if options.Fix && report.Summary["fail"] > 0 {
 		fmt.Println("\n🔧 Attempting to fix issues...")
-		attemptFixes(report)
+		report.Fixes = applyFixes(outcomes, os.Stdout, func(question string) error {
+			return confirmChange(question, options.Yes)
+		})
+		printFixResults(report.Fixes)
+	}
 
+	if anyFixed(report.Fixes) {
 		// After fixing, provide clear instructions about the current session
 		fmt.Println("\n📝 Important Note:")
 		fmt.Println("   The fixes have been applied to your shell profile files.")
@@ -196,10 +228,12 @@ func runHealthCheck(verbose, fix, performance, security bool) error {
 		fmt.Println()
 	}
 
-	return nil
+	return report, nil
 }
 
-func checkSystemEnvironment(report *HealthReport, verbose bool) {
+func checkSystemEnvironment(opts CheckOptions) []HealthStatus {
+	var results []HealthStatus
+
 	// Check OS compatibility
 	status := HealthStatus{Component: "OS Compatibility"}
 	if runtime.GOOS == "darwin" || runtime.GOOS == "linux" || runtime.GOOS == "windows" {
@@ -209,20 +243,19 @@ func checkSystemEnvironment(report *HealthReport, verbose bool) {
 		status.Status = "fail"
 		status.Message = fmt.Sprintf("OS %s is not officially supported", runtime.GOOS)
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
 
-	// Check architecture
+	// Check architecture against the platforms JFrog publishes binaries for
 	status = HealthStatus{Component: "Architecture"}
-	if runtime.GOARCH == "amd64" || runtime.GOARCH == "arm64" {
+	if _, err := internal.LookupPlatform(runtime.GOOS, runtime.GOARCH); err == nil {
 		status.Status = "pass"
 		status.Message = fmt.Sprintf("Architecture %s is supported", runtime.GOARCH)
 	} else {
 		status.Status = "warn"
-		status.Message = fmt.Sprintf("Architecture %s may have limited support", runtime.GOARCH)
+		status.Message = fmt.Sprintf("No JFrog CLI binaries are published for %s-%s", runtime.GOOS, runtime.GOARCH)
+		status.Details = "Supported: " + strings.Join(internal.SupportedPlatforms(), ", ")
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
 
 	// Check shell environment
 	status = HealthStatus{Component: "Shell Environment"}
@@ -230,24 +263,27 @@ func checkSystemEnvironment(report *HealthReport, verbose bool) {
 	if shell != "" {
 		status.Status = "pass"
 		status.Message = fmt.Sprintf("Shell %s detected", shell)
-		if verbose {
+		if opts.Verbose {
 			status.Details = fmt.Sprintf("Profile file: %s", utils.GetShellProfile(shell))
 		}
 	} else {
 		status.Status = "warn"
 		status.Message = "Shell detection failed"
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
+
+	return results
 }
 
-func checkjfcmInstallation(report *HealthReport, verbose bool) {
+func checkjfcmInstallation(opts CheckOptions) []HealthStatus {
+	var results []HealthStatus
+
 	// Check jfcm root directory
 	status := HealthStatus{Component: "jfcm Root Directory"}
 	if _, err := os.Stat(utils.JFCMRoot); err == nil {
 		status.Status = "pass"
 		status.Message = "jfcm root directory exists"
-		if verbose {
+		if opts.Verbose {
 			status.Details = utils.JFCMRoot
 		}
 	} else {
@@ -255,8 +291,7 @@ func checkjfcmInstallation(report *HealthReport, verbose bool) {
 		status.Message = "jfcm root directory missing"
 		status.Fixable = true
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
 
 	// Check versions directory
 	status = HealthStatus{Component: "Versions Directory"}
@@ -267,8 +302,7 @@ func checkjfcmInstallation(report *HealthReport, verbose bool) {
 		status.Status = "warn"
 		status.Message = "Versions directory missing (will be created on first install)"
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
 
 	// Check aliases directory
 	status = HealthStatus{Component: "Aliases Directory"}
@@ -279,11 +313,14 @@ func checkjfcmInstallation(report *HealthReport, verbose bool) {
 		status.Status = "warn"
 		status.Message = "Aliases directory missing (will be created on first alias)"
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
+
+	return results
 }
 
-func checkShimSetup(report *HealthReport, verbose bool, fix bool) {
+func checkShimSetup(opts CheckOptions) []HealthStatus {
+	var results []HealthStatus
+
 	// Check shim directory
 	status := HealthStatus{Component: "Shim Directory"}
 	if _, err := os.Stat(utils.JFCMShim); err == nil {
@@ -294,8 +331,7 @@ func checkShimSetup(report *HealthReport, verbose bool, fix bool) {
 		status.Message = "Shim directory missing"
 		status.Fixable = true
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
 
 	// Check shim binary
 	status = HealthStatus{Component: "Shim Binary"}
@@ -303,7 +339,7 @@ func checkShimSetup(report *HealthReport, verbose bool, fix bool) {
 	if _, err := os.Stat(shimPath); err == nil {
 		status.Status = "pass"
 		status.Message = "Shim binary exists"
-		if verbose {
+		if opts.Verbose {
 			status.Details = shimPath
 		}
 	} else {
@@ -311,8 +347,7 @@ func checkShimSetup(report *HealthReport, verbose bool, fix bool) {
 		status.Message = "Shim binary missing"
 		status.Fixable = true
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
 
 	// Check shim permissions
 	if runtime.GOOS != "windows" {
@@ -330,12 +365,15 @@ func checkShimSetup(report *HealthReport, verbose bool, fix bool) {
 			status.Status = "fail"
 			status.Message = "Cannot check shim permissions"
 		}
-		report.Checks = append(report.Checks, status)
-		report.Summary[status.Status]++
+		results = append(results, status)
 	}
+
+	return results
 }
 
-func checkPathPriority(report *HealthReport, verbose bool, fix bool) {
+func checkPathPriority(opts CheckOptions) []HealthStatus {
+	var results []HealthStatus
+
 	// Check PATH priority
 	status := HealthStatus{Component: "PATH Priority"}
 	if err := utils.VerifyPriority(); err == nil {
@@ -347,47 +385,31 @@ func checkPathPriority(report *HealthReport, verbose bool, fix bool) {
 		status.Details = err.Error()
 		status.Fixable = true
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
 
-	// Check which jf is active
-	status = HealthStatus{Component: "Active jf Binary"}
-	jfPath, err := exec.LookPath("jf")
-	if err != nil {
-		status.Status = "fail"
-		status.Message = "jf binary not found in PATH"
-	} else {
-		shimDir := filepath.Clean(utils.JFCMShim)
-		jfDir := filepath.Clean(filepath.Dir(jfPath))
-		if verbose {
-			fmt.Printf("[DEBUG] which jf: %s\n", jfPath)
-			fmt.Printf("[DEBUG] utils.JFCMShim: %s\n", shimDir)
-			fmt.Printf("[DEBUG] filepath.Dir(jfPath): %s\n", jfDir)
-		}
-		if jfDir == shimDir {
-			status.Status = "pass"
-			status.Message = "jfcm-managed jf is active"
-			if verbose {
-				status.Details = jfPath
-			}
-		} else {
-			status.Status = "fail"
-			status.Message = "System jf is active (not jfcm-managed)"
-			status.Details = fmt.Sprintf("Expected: %s/jf, Found: %s", shimDir, jfPath)
-			status.Fixable = true
+	// Check which jf is active and report every other jf on PATH
+	installations := utils.FindJfInstallations(os.Getenv("PATH"), true)
+	if opts.Debug {
+		for _, installation := range installations {
+			fmt.Printf("[DEBUG] jf on PATH[%d]: %s -> %s (%s)\n",
+				installation.PathIndex, installation.Path, installation.Resolved, installation.Origin)
 		}
+		fmt.Printf("[DEBUG] utils.JFCMShim: %s\n", filepath.Clean(utils.JFCMShim))
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, jfInstallationStatuses(installations, opts.Verbose)...)
 
-	if verbose {
+	if opts.Debug {
 		fmt.Println("  ℹ️  Note: This check reflects the current terminal session's PATH.")
 		fmt.Println("     If you recently ran 'jfcm use' or 'jfcm health-check --fix',")
 		fmt.Println("     you may need to 'source ~/.zshrc' (or ~/.bashrc) to see changes.")
 	}
+
+	return results
 }
 
-func checkShellProfileIntegrity(report *HealthReport, verbose bool, fix bool) {
+func checkShellProfileIntegrity(opts CheckOptions) []HealthStatus {
+	var results []HealthStatus
+
 	shell := utils.GetCurrentShell()
 	profileFile := utils.GetShellProfile(shell)
 
@@ -398,9 +420,8 @@ func checkShellProfileIntegrity(report *HealthReport, verbose bool, fix bool) {
 			Message:   fmt.Sprintf("Unsupported shell: %s", shell),
 			Details:   "Cannot check profile integrity for this shell type",
 		}
-		report.Checks = append(report.Checks, status)
-		report.Summary[status.Status]++
-		return
+		results = append(results, status)
+		return results
 	}
 
 	// Check if profile file exists
@@ -412,8 +433,7 @@ func checkShellProfileIntegrity(report *HealthReport, verbose bool, fix bool) {
 		status.Status = "pass"
 		status.Message = fmt.Sprintf("Profile file %s exists", filepath.Base(profileFile))
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
 
 	// Read and analyze profile content
 	content, err := os.ReadFile(profileFile)
@@ -424,9 +444,8 @@ func checkShellProfileIntegrity(report *HealthReport, verbose bool, fix bool) {
 			Message:   fmt.Sprintf("Cannot read profile file: %s", filepath.Base(profileFile)),
 			Details:   err.Error(),
 		}
-		report.Checks = append(report.Checks, status)
-		report.Summary[status.Status]++
-		return
+		results = append(results, status)
+		return results
 	}
 
 	profileContent := string(content)
@@ -504,7 +523,7 @@ func checkShellProfileIntegrity(report *HealthReport, verbose bool, fix bool) {
 	corruptionStatus := HealthStatus{Component: "Profile Corruption"}
 
 	// Debug output
-	if verbose {
+	if opts.Debug {
 		fmt.Printf("🔍 Corruption detection results:\n")
 		fmt.Printf("   - Total issues found: %d\n", len(issues))
 		fmt.Printf("   - Issues: %v\n", issues)
@@ -520,7 +539,7 @@ func checkShellProfileIntegrity(report *HealthReport, verbose bool, fix bool) {
 		corruptionStatus.Details = strings.Join(issues, "; ")
 		corruptionStatus.Fixable = true
 
-		if verbose {
+		if opts.Verbose {
 			corruptionStatus.Details += fmt.Sprintf("\n\nProfile file: %s", profileFile)
 			corruptionStatus.Details += fmt.Sprintf("\nCorrupted lines: %v", corruptedLines)
 		}
@@ -528,12 +547,14 @@ func checkShellProfileIntegrity(report *HealthReport, verbose bool, fix bool) {
 		corruptionStatus.Status = "pass"
 		corruptionStatus.Message = fmt.Sprintf("No corruption detected in %s", filepath.Base(profileFile))
 	}
-	report.Checks = append(report.Checks, corruptionStatus)
-	report.Summary[corruptionStatus.Status]++
+	results = append(results, corruptionStatus)
 
+	return results
 }
 
-func checkActiveVersion(report *HealthReport, verbose bool) {
+func checkActiveVersion(opts CheckOptions) []HealthStatus {
+	var results []HealthStatus
+
 	// Check active version
 	status := HealthStatus{Component: "Active Version"}
 	activeVersion, err := utils.GetActiveVersion()
@@ -548,14 +569,25 @@ func checkActiveVersion(report *HealthReport, verbose bool) {
 		binaryPath := filepath.Join(utils.JFCMVersions, activeVersion, utils.BinaryName)
 		if _, err := os.Stat(binaryPath); err == nil {
 			status.Details = "Binary exists"
+			if meta, _, err := utils.EnsureVersionMeta(activeVersion); err == nil {
+				status.Details = fmt.Sprintf("Installed by %s on %s for %s",
+					meta.Installer, meta.InstalledAt.Local().Format("2006-01-02"), meta.Platform)
+				if opts.Verbose && meta.SourceURL != "" {
+					status.Details += " from " + meta.SourceURL
+				}
+				if meta.Platform != utils.HostPlatform() {
+					status.Status = "warn"
+					status.Message = fmt.Sprintf("Active version %s was installed for %s, not %s",
+						activeVersion, meta.Platform, utils.HostPlatform())
+				}
+			}
 		} else {
 			status.Status = "fail"
 			status.Message = fmt.Sprintf("Active version %s binary missing", activeVersion)
 			status.Details = binaryPath
 		}
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
 
 	// Check installed versions
 	status = HealthStatus{Component: "Installed Versions"}
@@ -564,7 +596,7 @@ func checkActiveVersion(report *HealthReport, verbose bool) {
 		if count > 0 {
 			status.Status = "pass"
 			status.Message = fmt.Sprintf("%d version(s) installed", count)
-			if verbose {
+			if opts.Verbose {
 				var versions []string
 				for _, entry := range entries {
 					if entry.IsDir() {
@@ -581,11 +613,14 @@ func checkActiveVersion(report *HealthReport, verbose bool) {
 		status.Status = "warn"
 		status.Message = "Cannot read versions directory"
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
+
+	return results
 }
 
-func checkBinaryExecution(report *HealthReport, verbose bool) {
+func checkBinaryExecution(opts CheckOptions) []HealthStatus {
+	var results []HealthStatus
+
 	// Test jf execution
 	status := HealthStatus{Component: "jf Execution"}
 	cmd := exec.Command("jf", "--version")
@@ -599,12 +634,11 @@ func checkBinaryExecution(report *HealthReport, verbose bool) {
 	} else {
 		status.Status = "pass"
 		status.Message = "jf execution successful"
-		if verbose {
+		if opts.Verbose {
 			status.Details = strings.TrimSpace(string(output))
 		}
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
 
 	// Test jfcm execution
 	status = HealthStatus{Component: "jfcm Execution"}
@@ -617,41 +651,18 @@ func checkBinaryExecution(report *HealthReport, verbose bool) {
 		status.Status = "pass"
 		status.Message = "jfcm execution successful"
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
-}
+	results = append(results, status)
 
-func checkNetworkConnectivity(report *HealthReport, verbose bool) {
-	// Test GitHub API connectivity
-	status := HealthStatus{Component: "GitHub API"}
-	cmd := exec.Command("curl", "-s", "--max-time", "5", "https://api.github.com")
-	if err := cmd.Run(); err != nil {
-		status.Status = "warn"
-		status.Message = "GitHub API connectivity failed"
-		status.Details = "Cannot fetch latest version information"
-	} else {
-		status.Status = "pass"
-		status.Message = "GitHub API connectivity successful"
-	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	return results
+}
 
-	// Test JFrog releases connectivity
-	status = HealthStatus{Component: "JFrog Releases"}
-	cmd = exec.Command("curl", "-s", "--max-time", "5", "https://releases.jfrog.io")
-	if err := cmd.Run(); err != nil {
-		status.Status = "warn"
-		status.Message = "JFrog releases connectivity failed"
-		status.Details = "Cannot download JFrog CLI binaries"
-	} else {
-		status.Status = "pass"
-		status.Message = "JFrog releases connectivity successful"
-	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+func checkNetworkConnectivity(opts CheckOptions) []HealthStatus {
+	return probeEndpoints(newNetworkProber(), networkEndpoints(), opts.Verbose)
 }
 
-func checkPerformance(report *HealthReport, verbose bool) {
+func checkPerformance(opts CheckOptions) []HealthStatus {
+	var results []HealthStatus
+
 	// Test jfcm command performance
 	status := HealthStatus{Component: "jfcm Performance"}
 	start := time.Now()
@@ -669,8 +680,7 @@ func checkPerformance(report *HealthReport, verbose bool) {
 			status.Message = fmt.Sprintf("jfcm list took %v (slow)", duration)
 		}
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
 
 	// Test jf command performance
 	status = HealthStatus{Component: "jf Performance"}
@@ -691,11 +701,14 @@ func checkPerformance(report *HealthReport, verbose bool) {
 			status.Message = fmt.Sprintf("jf version took %v (slow)", duration)
 		}
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
+
+	return results
 }
 
-func checkSecurity(report *HealthReport, verbose bool) {
+func checkSecurity(opts CheckOptions) []HealthStatus {
+	var results []HealthStatus
+
 	// Check file permissions
 	status := HealthStatus{Component: "File Permissions"}
 	shimPath := filepath.Join(utils.JFCMShim, utils.BinaryName)
@@ -713,8 +726,7 @@ func checkSecurity(report *HealthReport, verbose bool) {
 		status.Status = "warn"
 		status.Message = "Cannot check shim permissions"
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
 
 	// Check for suspicious files
 	status = HealthStatus{Component: "Suspicious Files"}
@@ -737,8 +749,9 @@ func checkSecurity(report *HealthReport, verbose bool) {
 		status.Status = "pass"
 		status.Message = "No suspicious files found"
 	}
-	report.Checks = append(report.Checks, status)
-	report.Summary[status.Status]++
+	results = append(results, status)
+
+	return results
 }
 
 func printHealthResults(checks []HealthStatus, verbose bool) {
@@ -758,64 +771,45 @@ func printHealthResults(checks []HealthStatus, verbose bool) {
 	}
 }
 
-func attemptFixes(report *HealthReport) {
-	fmt.Println("🔧 Attempting to fix issues...")
-
+func printFixResults(fixes []FixResult) {
 	fixesApplied := false
-	for _, check := range report.Checks {
-		if check.Status == "fail" && check.Fixable {
-			fmt.Printf("  Fixing %s...\n", check.Component)
-
-			switch check.Component {
-			case "jfcm Root Directory":
-				if err := os.MkdirAll(utils.JFCMRoot, 0755); err == nil {
-					fmt.Printf("    ✅ Created jfcm root directory\n")
-					fixesApplied = true
-				} else {
-					fmt.Printf("    ❌ Failed to create jfcm root directory: %v\n", err)
-				}
-			case "Shim Directory":
-				if err := os.MkdirAll(utils.JFCMShim, 0755); err == nil {
-					fmt.Printf("    ✅ Created shim directory\n")
-					fixesApplied = true
-				} else {
-					fmt.Printf("    ❌ Failed to create shim directory: %v\n", err)
-				}
-			case "Shim Binary":
-				if err := utils.SetupShim(); err == nil {
-					fmt.Printf("    ✅ Created shim binary\n")
-					fixesApplied = true
-				} else {
-					fmt.Printf("    ❌ Failed to create shim binary: %v\n", err)
-				}
-			case "Shim Permissions":
-				shimPath := filepath.Join(utils.JFCMShim, utils.BinaryName)
-				if err := os.Chmod(shimPath, 0755); err == nil {
-					fmt.Printf("    ✅ Fixed shim permissions\n")
-					fixesApplied = true
-				} else {
-					fmt.Printf("    ❌ Failed to fix shim permissions: %v\n", err)
-				}
-			case "PATH Priority", "Active jf Binary":
-				if err := utils.UpdatePATH(); err == nil {
-					fmt.Printf("    ✅ Updated PATH configuration\n")
-					fixesApplied = true
-				} else {
-					fmt.Printf("    ❌ Failed to update PATH configuration: %v\n", err)
-				}
-			}
+	for _, fix := range fixes {
+		fmt.Printf("  Fixing %s...\n", fix.Component)
+		if fix.Fixed {
+			fmt.Printf("    ✅ Fixed %s\n", fix.Component)
+			fixesApplied = true
+		} else {
+			fmt.Printf("    ❌ %s\n", fix.Error)
 		}
 	}
 
 	if fixesApplied {
 		fmt.Println("\n✅ Fixes applied successfully!")
 	} else {
-		fmt.Println("\n⚠️  No fixes were applied (no fixable issues found)")
+		fmt.Println("\n⚠️  No fixes were applied")
 	}
 }
 
-func runHealthCheckJSON(verbose, fix, performance, security bool) error {
-	// Implementation for JSON output would go here
-	// For now, just return an error indicating JSON is not implemented
-	return fmt.Errorf("JSON output not yet implemented")
+func runHealthCheckJSON(options HealthCheckOptions) (*HealthReport, error) {
+	checks := selectChecks(healthChecks, options.Selection)
+	outcomes := runChecks(checks, CheckOptions{Verbose: options.Verbose}, nil)
+	report := newHealthReport(outcomes)
+
+	if options.Fix && report.Summary["fail"] > 0 {
+		// Keep the JSON document on stdout clean; prompting would write to it, so fixes that
+		// need confirmation only run with --yes
+		report.Fixes = applyFixes(outcomes, os.Stderr, func(string) error {
+			if !options.Yes {
+				return errChangeNotConfirmed
+			}
+			return nil
+		})
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode health report: %w", err)
+	}
+	fmt.Println(string(data))
+	return report, nil
 }
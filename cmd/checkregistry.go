@@ -0,0 +1,346 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+)
+
+// Health check categories accepted by --only, --skip and --fail-on
+const (
+	CheckCategorySystem       = "system"
+	CheckCategoryInstallation = "installation"
+	CheckCategoryShim         = "shim"
+	CheckCategoryPath         = "path"
+	CheckCategoryProfile      = "profile"
+	CheckCategoryVersions     = "versions"
+	CheckCategoryIntegrity    = "integrity"
+	CheckCategoryExecution    = "execution"
+	CheckCategoryNetwork      = "network"
+	CheckCategoryPerformance  = "performance"
+	CheckCategorySecurity     = "security"
+)
+
+// errNoAutomaticFix is returned by fixers for issues they cannot repair
+var errNoAutomaticFix = errors.New("no automatic fix available")
+
+// CheckOptions are passed to every check
+type CheckOptions struct {
+	Verbose bool
+	Debug   bool // print diagnostics to stdout; off for JSON output
+}
+
+// Check is a health-check step producing one or more statuses
+type Check interface {
+	Name() string
+	Category() string
+	Run(opts CheckOptions) []HealthStatus
+}
+
+// Fixer is implemented by checks that can repair the failures they report. Progress is written to out,
+// which is stderr when the report itself goes to stdout as JSON.
+type Fixer interface {
+	Fix(status HealthStatus, out io.Writer) error
+}
+
+// ConfirmedFixer is implemented by checks whose fixes change files outside ~/.jfcm, such as the
+// shell profile. FixQuestion returns the question to ask before fixing a status, or "" when the
+// fix needs no confirmation.
+type ConfirmedFixer interface {
+	FixQuestion(status HealthStatus) string
+}
+
+// OptionalCheck is implemented by checks that only run when requested
+type OptionalCheck interface {
+	Optional() bool
+}
+
+// healthCheck adapts a check function to the Check interface
+type healthCheck struct {
+	name     string
+	icon     string
+	category string
+	optional bool
+	run      func(opts CheckOptions) []HealthStatus
+	fix      func(status HealthStatus, out io.Writer) error
+	question func(status HealthStatus) string
+}
+
+func (c *healthCheck) Name() string                         { return c.name }
+func (c *healthCheck) Category() string                     { return c.category }
+func (c *healthCheck) Optional() bool                       { return c.optional }
+func (c *healthCheck) Run(opts CheckOptions) []HealthStatus { return c.run(opts) }
+
+// Fix repairs a failed status, or returns errNoAutomaticFix
+func (c *healthCheck) Fix(status HealthStatus, out io.Writer) error {
+	if c.fix == nil {
+		return errNoAutomaticFix
+	}
+	return c.fix(status, out)
+}
+
+// FixQuestion returns what to ask before fixing a status, or "" when the fix needs no confirmation
+func (c *healthCheck) FixQuestion(status HealthStatus) string {
+	if c.question == nil {
+		return ""
+	}
+	return c.question(status)
+}
+
+// Title returns the heading printed above the check results
+func (c *healthCheck) Title() string {
+	return c.icon + " " + c.name
+}
+
+// healthChecks is the registry of checks in execution order
+var healthChecks = []Check{
+	&healthCheck{name: "System Environment", icon: "🔧", category: CheckCategorySystem, run: checkSystemEnvironment},
+	&healthCheck{name: "jfcm Installation", icon: "📦", category: CheckCategoryInstallation, run: checkjfcmInstallation, fix: fixjfcmInstallation},
+	&healthCheck{name: "Shim Setup", icon: "🔗", category: CheckCategoryShim, run: checkShimSetup, fix: fixShimSetup},
+	&healthCheck{name: "PATH Priority", icon: "🎯", category: CheckCategoryPath, run: checkPathPriority, fix: fixPathPriority, question: pathPriorityQuestion},
+	&healthCheck{name: "Shell Profile Integrity", icon: "🧹", category: CheckCategoryProfile, run: checkShellProfileIntegrity},
+	&healthCheck{name: "Active Version", icon: "📋", category: CheckCategoryVersions, run: checkActiveVersion},
+	&healthCheck{name: "Binary Integrity", icon: "🔐", category: CheckCategoryIntegrity, run: checkBinaryIntegrity, fix: fixBinaryIntegrity},
+	&healthCheck{name: "Binary Execution", icon: "⚡", category: CheckCategoryExecution, run: checkBinaryExecution},
+	&healthCheck{name: "Network Connectivity", icon: "🌐", category: CheckCategoryNetwork, run: checkNetworkConnectivity},
+	&healthCheck{name: "Performance", icon: "🚀", category: CheckCategoryPerformance, optional: true, run: checkPerformance},
+	&healthCheck{name: "Security", icon: "🔒", category: CheckCategorySecurity, optional: true, run: checkSecurity},
+}
+
+// checkTitle returns the heading of a check
+func checkTitle(check Check) string {
+	if titled, ok := check.(interface{ Title() string }); ok {
+		return titled.Title()
+	}
+	return check.Name()
+}
+
+// CheckCategories returns the categories of all registered checks
+func CheckCategories() []string {
+	var categories []string
+	seen := make(map[string]bool)
+	for _, check := range healthChecks {
+		if !seen[check.Category()] {
+			seen[check.Category()] = true
+			categories = append(categories, check.Category())
+		}
+	}
+	return categories
+}
+
+// parseCheckCategories parses a comma-separated category list; "all" selects every category
+func parseCheckCategories(flag, value string) (map[string]bool, error) {
+	selected := make(map[string]bool)
+	if strings.TrimSpace(value) == "" {
+		return selected, nil
+	}
+
+	known := make(map[string]bool)
+	for _, category := range CheckCategories() {
+		known[category] = true
+	}
+
+	for _, category := range strings.Split(value, ",") {
+		category = strings.ToLower(strings.TrimSpace(category))
+		switch {
+		case category == "all":
+			for name := range known {
+				selected[name] = true
+			}
+		case known[category]:
+			selected[category] = true
+		default:
+			return nil, fmt.Errorf("❌ unknown check category '%s' in --%s (supported: %s, all)",
+				category, flag, strings.Join(CheckCategories(), ", "))
+		}
+	}
+	return selected, nil
+}
+
+// CheckSelection decides which registered checks run
+type CheckSelection struct {
+	Only     map[string]bool
+	Skip     map[string]bool
+	Optional map[string]bool // optional categories enabled by flags such as --performance
+}
+
+// selectChecks returns the checks to run. Optional checks run when enabled or named in --only.
+func selectChecks(checks []Check, selection CheckSelection) []Check {
+	var selected []Check
+	for _, check := range checks {
+		category := check.Category()
+		if selection.Skip[category] {
+			continue
+		}
+		if len(selection.Only) > 0 {
+			if selection.Only[category] {
+				selected = append(selected, check)
+			}
+			continue
+		}
+		if optional, ok := check.(OptionalCheck); ok && optional.Optional() && !selection.Optional[category] {
+			continue
+		}
+		selected = append(selected, check)
+	}
+	return selected
+}
+
+// checkOutcome holds the statuses produced by one check
+type checkOutcome struct {
+	Check    Check
+	Statuses []HealthStatus
+}
+
+// runChecks runs the checks in order, tagging every status with its category
+func runChecks(checks []Check, opts CheckOptions, each func(check Check, statuses []HealthStatus)) []checkOutcome {
+	var outcomes []checkOutcome
+	for _, check := range checks {
+		statuses := check.Run(opts)
+		for i := range statuses {
+			statuses[i].Category = check.Category()
+		}
+		if each != nil {
+			each(check, statuses)
+		}
+		outcomes = append(outcomes, checkOutcome{Check: check, Statuses: statuses})
+	}
+	return outcomes
+}
+
+// FixResult records an attempted fix
+type FixResult struct {
+	Component string `json:"component"`
+	Fixed     bool   `json:"fixed"`
+	Error     string `json:"error,omitempty"`
+}
+
+// applyFixes calls the fixer of every check for each of its fixable failures, writing progress to out.
+// Fixes that need confirmation are only applied when approve returns nil for their question; each
+// question is asked once.
+func applyFixes(outcomes []checkOutcome, out io.Writer, approve func(question string) error) []FixResult {
+	var fixes []FixResult
+	answers := make(map[string]error)
+	for _, outcome := range outcomes {
+		fixer, ok := outcome.Check.(Fixer)
+		confirmed, _ := outcome.Check.(ConfirmedFixer)
+		for _, status := range outcome.Statuses {
+			if status.Status != "fail" || !status.Fixable {
+				continue
+			}
+			result := FixResult{Component: status.Component}
+			err := errNoAutomaticFix
+			if ok {
+				err = nil
+				if confirmed != nil {
+					if question := confirmed.FixQuestion(status); question != "" {
+						answer, asked := answers[question]
+						if !asked {
+							answer = approve(question)
+							answers[question] = answer
+						}
+						err = answer
+					}
+				}
+				if err == nil {
+					err = fixer.Fix(status, out)
+				}
+			}
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Fixed = true
+			}
+			fixes = append(fixes, result)
+		}
+	}
+	return fixes
+}
+
+// anyFixed reports whether at least one fix succeeded
+func anyFixed(fixes []FixResult) bool {
+	for _, fix := range fixes {
+		if fix.Fixed {
+			return true
+		}
+	}
+	return false
+}
+
+// failedCategories returns the sorted categories in failOn with failures; strict counts warnings too
+func failedCategories(checks []HealthStatus, failOn map[string]bool, strict bool) []string {
+	failed := make(map[string]bool)
+	for _, check := range checks {
+		if !failOn[check.Category] {
+			continue
+		}
+		if check.Status == "fail" || (strict && check.Status == "warn") {
+			failed[check.Category] = true
+		}
+	}
+
+	var categories []string
+	for category := range failed {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	return categories
+}
+
+func fixjfcmInstallation(status HealthStatus, out io.Writer) error {
+	if status.Component != "jfcm Root Directory" {
+		return errNoAutomaticFix
+	}
+	if err := os.MkdirAll(utils.JFCMRoot, 0755); err != nil {
+		return fmt.Errorf("failed to create jfcm root directory: %w", err)
+	}
+	return nil
+}
+
+func fixShimSetup(status HealthStatus, out io.Writer) error {
+	switch status.Component {
+	case "Shim Directory":
+		if err := os.MkdirAll(utils.JFCMShim, 0755); err != nil {
+			return fmt.Errorf("failed to create shim directory: %w", err)
+		}
+	case "Shim Binary":
+		if err := utils.SetupShim(); err != nil {
+			return fmt.Errorf("failed to create shim binary: %w", err)
+		}
+	case "Shim Permissions":
+		if err := os.Chmod(filepath.Join(utils.JFCMShim, utils.BinaryName), 0755); err != nil {
+			return fmt.Errorf("failed to fix shim permissions: %w", err)
+		}
+	default:
+		return errNoAutomaticFix
+	}
+	return nil
+}
+
+// pathPriorityQuestion describes what fixing the PATH priority changes, since the fix edits the
+// shell profile and may copy a jf installed outside jfcm
+func pathPriorityQuestion(status HealthStatus) string {
+	if strings.HasPrefix(status.Component, jfConflictPrefix) {
+		path := strings.TrimPrefix(status.Component, jfConflictPrefix)
+		return fmt.Sprintf("Copy %s into jfcm and put the jfcm shim first in PATH in your shell profile?", path)
+	}
+	return "Put the jfcm shim first in PATH in your shell profile?"
+}
+
+func fixPathPriority(status HealthStatus, out io.Writer) error {
+	if strings.HasPrefix(status.Component, jfConflictPrefix) {
+		if err := adoptConflictingJf(status.Component, out); err != nil {
+			return err
+		}
+	}
+	if err := utils.UpdatePATHTo(out); err != nil {
+		return fmt.Errorf("failed to update PATH configuration: %w", err)
+	}
+	return nil
+}
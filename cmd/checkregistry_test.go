@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fakeCheck is a registry entry with canned statuses
+type fakeCheck struct {
+	healthCheck
+	fixed []string
+}
+
+func newFakeCheck(name, category string, optional bool, statuses ...HealthStatus) *fakeCheck {
+	check := &fakeCheck{}
+	check.healthCheck = healthCheck{
+		name:     name,
+		category: category,
+		optional: optional,
+		run:      func(CheckOptions) []HealthStatus { return append([]HealthStatus{}, statuses...) },
+		fix: func(status HealthStatus, out io.Writer) error {
+			if status.Component == "unfixable" {
+				return errors.New("boom")
+			}
+			fmt.Fprintf(out, "fixing %s\n", status.Component)
+			check.fixed = append(check.fixed, status.Component)
+			return nil
+		},
+	}
+	return check
+}
+
+func checkNames(checks []Check) []string {
+	var names []string
+	for _, check := range checks {
+		names = append(names, check.Name())
+	}
+	return names
+}
+
+func TestSelectChecks(t *testing.T) {
+	checks := []Check{
+		newFakeCheck("a", CheckCategorySystem, false),
+		newFakeCheck("b", CheckCategoryShim, false),
+		newFakeCheck("c", CheckCategoryPerformance, true),
+	}
+
+	cases := []struct {
+		name      string
+		selection CheckSelection
+		expected  []string
+	}{
+		{"default skips optional", CheckSelection{}, []string{"a", "b"}},
+		{"optional enabled", CheckSelection{Optional: map[string]bool{CheckCategoryPerformance: true}}, []string{"a", "b", "c"}},
+		{"only includes optional", CheckSelection{Only: map[string]bool{CheckCategoryPerformance: true}}, []string{"c"}},
+		{"skip", CheckSelection{Skip: map[string]bool{CheckCategorySystem: true}}, []string{"b"}},
+		{"only and skip", CheckSelection{Only: map[string]bool{CheckCategorySystem: true, CheckCategoryShim: true}, Skip: map[string]bool{CheckCategoryShim: true}}, []string{"a"}},
+	}
+	for _, tc := range cases {
+		if got := checkNames(selectChecks(checks, tc.selection)); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestRunChecksFixesAndExitCategories(t *testing.T) {
+	shim := newFakeCheck("shim", CheckCategoryShim, false,
+		HealthStatus{Status: "fail", Component: "fixable", Fixable: true},
+		HealthStatus{Status: "fail", Component: "unfixable", Fixable: true},
+		HealthStatus{Status: "fail", Component: "manual"},
+	)
+	network := newFakeCheck("network", CheckCategoryNetwork, false, HealthStatus{Status: "warn", Component: "api"})
+
+	outcomes := runChecks([]Check{shim, network}, CheckOptions{}, nil)
+	report := newHealthReport(outcomes)
+	if report.Overall != "FAILED" || report.Summary["fail"] != 3 || report.Summary["warn"] != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if report.Checks[3].Category != CheckCategoryNetwork {
+		t.Errorf("statuses should be tagged with their category, got %q", report.Checks[3].Category)
+	}
+
+	var progress bytes.Buffer
+	fixes := applyFixes(outcomes, &progress, func(string) error { return errors.New("unexpected question") })
+	if len(fixes) != 2 || !fixes[0].Fixed || fixes[1].Fixed || fixes[1].Error != "boom" {
+		t.Errorf("unexpected fixes: %+v", fixes)
+	}
+	if progress.String() != "fixing fixable\n" {
+		t.Errorf("expected fix progress to go to the given writer, got %q", progress.String())
+	}
+	if !reflect.DeepEqual(shim.fixed, []string{"fixable"}) {
+		t.Errorf("unexpected fixed components: %v", shim.fixed)
+	}
+
+	failOn := map[string]bool{CheckCategoryNetwork: true}
+	if got := failedCategories(report.Checks, failOn, false); len(got) != 0 {
+		t.Errorf("warnings should not fail without --strict, got %v", got)
+	}
+	if got := failedCategories(report.Checks, failOn, true); !reflect.DeepEqual(got, []string{CheckCategoryNetwork}) {
+		t.Errorf("expected network to fail with --strict, got %v", got)
+	}
+	failOn[CheckCategoryShim] = true
+	if got := failedCategories(report.Checks, failOn, false); !reflect.DeepEqual(got, []string{CheckCategoryShim}) {
+		t.Errorf("expected shim to fail, got %v", got)
+	}
+}
+
+func TestApplyFixesAsksBeforeConfirmedFixes(t *testing.T) {
+	path := newFakeCheck("PATH Priority", CheckCategoryPath, false,
+		HealthStatus{Component: "PATH Priority", Status: "fail", Fixable: true},
+		HealthStatus{Component: "Active jf Binary", Status: "fail", Fixable: true},
+		HealthStatus{Component: "jf at /usr/local/bin/jf", Status: "fail", Fixable: true})
+	path.question = pathPriorityQuestion
+	outcomes := runChecks([]Check{path}, CheckOptions{}, nil)
+
+	var asked []string
+	fixes := applyFixes(outcomes, io.Discard, func(question string) error {
+		asked = append(asked, question)
+		return errChangeNotConfirmed
+	})
+	for _, fix := range fixes {
+		if fix.Fixed || fix.Error != errChangeNotConfirmed.Error() {
+			t.Errorf("expected the unconfirmed fix to be refused, got %+v", fix)
+		}
+	}
+	if len(fixes) != 3 || len(path.fixed) != 0 {
+		t.Errorf("expected no fix without confirmation, got %+v and %v", fixes, path.fixed)
+	}
+	// Statuses sharing a question are asked about once
+	if len(asked) != 2 || asked[0] != "Put the jfcm shim first in PATH in your shell profile?" ||
+		!strings.Contains(asked[1], "Copy /usr/local/bin/jf into jfcm") {
+		t.Errorf("unexpected questions: %q", asked)
+	}
+
+	fixes = applyFixes(outcomes, io.Discard, func(string) error { return nil })
+	if len(fixes) != 3 || !anyFixed(fixes) || len(path.fixed) != 3 {
+		t.Errorf("expected the confirmed fixes to run, got %+v and %v", fixes, path.fixed)
+	}
+
+	// Without a terminal, only --yes confirms
+	if err := confirmChange("Continue?", true); err != nil {
+		t.Errorf("expected --yes to confirm, got %v", err)
+	}
+	if !isInteractive() {
+		if err := confirmChange("Continue?", false); !errors.Is(err, errChangeNotConfirmed) {
+			t.Errorf("expected a refusal without a terminal, got %v", err)
+		}
+	}
+}
+
+func TestParseCheckCategories(t *testing.T) {
+	selected, err := parseCheckCategories("only", "Shim, path")
+	if err != nil || !selected[CheckCategoryShim] || !selected[CheckCategoryPath] || len(selected) != 2 {
+		t.Errorf("unexpected selection %v, %v", selected, err)
+	}
+	all, err := parseCheckCategories("fail-on", "all")
+	if err != nil || len(all) != len(CheckCategories()) {
+		t.Errorf("expected all categories, got %v, %v", all, err)
+	}
+	if _, err := parseCheckCategories("skip", "shim,nope"); err == nil {
+		t.Error("expected an error for an unknown category")
+	}
+}
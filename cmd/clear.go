@@ -4,19 +4,58 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/jfrog/jfrog-cli-manager/cmd/descriptions"
 	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
 	"github.com/urfave/cli/v2"
 )
 
 var Clear = &cli.Command{
-	Name:  "clear",
-	Usage: "Remove all installed JFrog CLI versions",
-	Action: func(c *cli.Context) error {
-		err := os.RemoveAll(utils.JFCMVersions)
+	Name:        "clear",
+	Usage:       descriptions.Clear.Usage,
+	Description: descriptions.Clear.Format(),
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:    "force",
+			Aliases: []string{"f"},
+			Usage:   "Remove all versions without asking",
+		},
+	},
+	Action: withStateLock(func(c *cli.Context) error {
+		versions, err := installedVersionDirs()
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("❌ Failed to read %s: %v", utils.JFCMVersions, err), 1)
+		}
+
+		guards, err := versionGuards(versions)
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+		warnings := []string{fmt.Sprintf("This removes all %d installed versions", len(versions))}
+		active := ""
+		for _, guard := range guards {
+			warnings = append(warnings, guard.Warnings()...)
+			if guard.Active {
+				active = guard.Version
+			}
+		}
+		if len(versions) > 0 {
+			if err := confirmRemoval(warnings, "Remove all versions?", c.Bool("force")); err != nil {
+				return cli.Exit(err.Error(), 1)
+			}
+		}
+
+		err = os.RemoveAll(utils.JFCMVersions)
 		if err != nil {
 			return fmt.Errorf("failed to clear versions: %w", err)
 		}
 		fmt.Println("All versions removed.")
+
+		if active != "" {
+			if err := offerSwitch(active); err != nil {
+				return cli.Exit(err.Error(), 1)
+			}
+		}
+		reportDanglingAliases()
 		return nil
-	},
+	}),
 }
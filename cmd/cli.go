@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
@@ -57,6 +58,7 @@ type ExecutionResult struct {
 	ExitCode  int
 	Duration  time.Duration
 	StartTime time.Time
+	Usage     ResourceUsage
 }
 
 // diffChange represents a single change in a diff
@@ -101,7 +103,8 @@ func findSeparator(args []string, separator string) int {
 }
 
 // executeJFCommand executes a JFrog CLI command with the specified version
-func executeJFCommand(ctx context.Context, version string, jfCommand []string) (ExecutionResult, error) {
+// Extra env entries (such as an isolated JFROG_CLI_HOME_DIR) are added to the inherited environment.
+func executeJFCommand(ctx context.Context, version string, jfCommand []string, env []string) (ExecutionResult, error) {
 	result := ExecutionResult{
 		Version:   version,
 		Command:   strings.Join(jfCommand, " "),
@@ -109,8 +112,17 @@ func executeJFCommand(ctx context.Context, version string, jfCommand []string) (
 	}
 
 	binPath := filepath.Join(utils.JFCMVersions, version, utils.BinaryName)
+	runJFBinary(ctx, &result, binPath, jfCommand, env)
 
-	cmd := exec.CommandContext(ctx, binPath, jfCommand...)
+	return result, nil
+}
+
+// runJFBinary runs a jf binary and records its output, exit code, duration and resource usage into result
+func runJFBinary(ctx context.Context, result *ExecutionResult, binPath string, args []string, env []string) {
+	cmd := exec.CommandContext(ctx, binPath, args...)
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -118,6 +130,7 @@ func executeJFCommand(ctx context.Context, version string, jfCommand []string) (
 
 	err := cmd.Run()
 	result.Duration = time.Since(result.StartTime)
+	result.Usage = processResourceUsage(cmd.ProcessState)
 
 	stdoutStr := stdout.String()
 	stderrStr := stderr.String()
@@ -147,12 +160,10 @@ func executeJFCommand(ctx context.Context, version string, jfCommand []string) (
 			result.Output = stderrStr
 		}
 	}
-
-	return result, nil
 }
 
 // displayComparison displays the comparison results between two CLI executions
-func displayComparison(result1, result2 ExecutionResult, unified, noColor, showTiming bool) {
+func displayComparison(result1, result2 ExecutionResult, normalizer *OutputNormalizer, unified, noColor, showTiming bool) {
 	colors := NewColorScheme(noColor)
 
 	displayComparisonHeader()
@@ -169,7 +180,7 @@ func displayComparison(result1, result2 ExecutionResult, unified, noColor, showT
 		displayErrorOutput(result1, result2, colors)
 	}
 
-	displayOutputDiff(result1, result2, unified, colors)
+	displayOutputDiff(result1, result2, normalizer, unified, colors)
 }
 
 // displayComparisonHeader shows the comparison results header
@@ -226,11 +237,11 @@ func displayErrorOutput(result1, result2 ExecutionResult, colors *ColorScheme) {
 }
 
 // displayOutputDiff compares and displays output differences
-func displayOutputDiff(result1, result2 ExecutionResult, unified bool, colors *ColorScheme) {
-	output1, output2 := prepareOutputsForComparison(result1, result2)
+func displayOutputDiff(result1, result2 ExecutionResult, normalizer *OutputNormalizer, unified bool, colors *ColorScheme) {
+	output1, output2 := prepareOutputsForComparison(result1, result2, normalizer)
 
 	// Check if outputs are identical
-	if areOutputsIdentical(output1, output2, result1, result2) {
+	if areOutputsIdentical(output1, output2, result1, result2, normalizer) {
 		displayIdenticalOutputs(output1)
 		return
 	}
@@ -244,26 +255,27 @@ func displayOutputDiff(result1, result2 ExecutionResult, unified bool, colors *C
 	}
 }
 
-// prepareOutputsForComparison prepares outputs for comparison, handling error fallback
-func prepareOutputsForComparison(result1, result2 ExecutionResult) (string, string) {
-	output1 := strings.TrimSpace(result1.Output)
+// prepareOutputsForComparison prepares normalized outputs for comparison, handling error fallback
+func prepareOutputsForComparison(result1, result2 ExecutionResult, normalizer *OutputNormalizer) (string, string) {
+	output1 := normalizer.Normalize(result1.Output)
 	if output1 == "" && result1.ErrorMsg != "" {
-		output1 = strings.TrimSpace(result1.ErrorMsg)
+		output1 = normalizer.Normalize(result1.ErrorMsg)
 	}
 
-	output2 := strings.TrimSpace(result2.Output)
+	output2 := normalizer.Normalize(result2.Output)
 	if output2 == "" && result2.ErrorMsg != "" {
-		output2 = strings.TrimSpace(result2.ErrorMsg)
+		output2 = normalizer.Normalize(result2.ErrorMsg)
 	}
 
 	return output1, output2
 }
 
 // areOutputsIdentical checks if outputs are considered identical
-func areOutputsIdentical(output1, output2 string, result1, result2 ExecutionResult) bool {
+func areOutputsIdentical(output1, output2 string, result1, result2 ExecutionResult, normalizer *OutputNormalizer) bool {
 	// Commands with different exit codes should never be considered identical
 	// Even if their stdout happens to be the same, they represent different execution results
-	return output1 == output2 && result1.ExitCode == result2.ExitCode && result1.ErrorMsg == result2.ErrorMsg
+	return output1 == output2 && result1.ExitCode == result2.ExitCode &&
+		normalizer.Normalize(result1.ErrorMsg) == normalizer.Normalize(result2.ErrorMsg)
 }
 
 // displayIdenticalOutputs shows when outputs are identical
@@ -277,28 +289,17 @@ func displayIdenticalOutputs(output string) {
 	}
 }
 
-// displayUnifiedDiff displays output differences in unified diff format
-func displayUnifiedDiff(output1, output2, version1, version2 string, colors *ColorScheme) {
+// computeLineDiff builds a simple line-by-line diff between two outputs
+func computeLineDiff(output1, output2 string) []diffChange {
 	lines1 := strings.Split(output1, "\n")
 	lines2 := strings.Split(output2, "\n")
 
-	// Header
-	fmt.Printf("─────────────────────────────────────────────────────────────────────────────────────\n")
-	fmt.Printf("%s %s\n", colors.Red.Sprint("---"), colors.Cyan.Sprint(version1))
-	fmt.Printf("%s %s\n", colors.Green.Sprint("+++"), colors.Cyan.Sprint(version2))
-	fmt.Printf("─────────────────────────────────────────────────────────────────────────────────────\n")
-
-	// Create a simple line-based diff
 	maxLines := len(lines1)
 	if len(lines2) > maxLines {
 		maxLines = len(lines2)
 	}
 
-	// Track context for cleaner output
-	contextSize := DefaultContextSize
 	changes := []diffChange{}
-
-	// Identify all changes first
 	for i := 0; i < maxLines; i++ {
 		line1 := ""
 		line2 := ""
@@ -324,6 +325,21 @@ func displayUnifiedDiff(output1, output2, version1, version2 string, colors *Col
 		}
 	}
 
+	return changes
+}
+
+// displayUnifiedDiff displays output differences in unified diff format
+func displayUnifiedDiff(output1, output2, version1, version2 string, colors *ColorScheme) {
+	// Header
+	fmt.Printf("─────────────────────────────────────────────────────────────────────────────────────\n")
+	fmt.Printf("%s %s\n", colors.Red.Sprint("---"), colors.Cyan.Sprint(version1))
+	fmt.Printf("%s %s\n", colors.Green.Sprint("+++"), colors.Cyan.Sprint(version2))
+	fmt.Printf("─────────────────────────────────────────────────────────────────────────────────────\n")
+
+	// Track context for cleaner output
+	contextSize := DefaultContextSize
+	changes := computeLineDiff(output1, output2)
+
 	// Display changes with context
 	for i, change := range changes {
 		switch change.changeType {
@@ -85,6 +85,32 @@ var CompareChangelog = &cli.Command{
 			Usage: "Show execution timing information",
 			Value: true,
 		},
+		&cli.BoolFlag{
+			Name:    "offline",
+			Usage:   "Use only cached release data, without contacting the GitHub API",
+			EnvVars: []string{"JFCM_OFFLINE"},
+		},
+		&cli.IntFlag{
+			Name:  "limit",
+			Usage: "Show at most this many releases, keeping the newest (0 shows the full range)",
+			Value: 0,
+		},
+		&cli.BoolFlag{
+			Name:  "reverse",
+			Usage: "Show the newest release first",
+		},
+		&cli.BoolFlag{
+			Name:  "consolidated",
+			Usage: "Group the entries of all releases by section (Breaking Changes, Features, Bug Fixes, ...)",
+		},
+		&cli.StringFlag{
+			Name:  "grep",
+			Usage: "Show only release note lines containing this term (case-insensitive) and the releases they appear in",
+		},
+		&cli.BoolFlag{
+			Name:  "breaking",
+			Usage: "Show only breaking changes, deprecations and flag removals",
+		},
 	},
 	Action: func(c *cli.Context) error {
 		args := c.Args().Slice()
@@ -125,6 +151,24 @@ var CompareCli = &cli.Command{
 			Usage: "Show execution timing information",
 			Value: true,
 		},
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "Output format: table, json, markdown, junit",
+			Value: CompareFormatTable,
+		},
+		&cli.BoolFlag{
+			Name:  "fail-on-diff",
+			Usage: "Exit with a non-zero code when exit codes or normalized outputs differ",
+			Value: false,
+		},
+		&cli.StringSliceFlag{
+			Name:  "ignore-pattern",
+			Usage: "Regular expression whose matches are masked before outputs are compared (repeatable)",
+		},
+		&cli.StringFlag{
+			Name:  "isolate-home",
+			Usage: "Run each jf against a temporary copy of the JFrog CLI home (JFROG_CLI_HOME_DIR): per-version or per-run",
+		},
 	},
 	Action: func(c *cli.Context) error {
 		args := c.Args().Slice()
@@ -149,8 +193,19 @@ var CompareCli = &cli.Command{
 			return fmt.Errorf("version %s (%s) not found: %w", config.Version2, config.Resolved2, err)
 		}
 
-		fmt.Printf("🔄 Comparing JFrog CLI versions: %s vs %s\n", config.Version1, config.Version2)
-		fmt.Printf("📝 Command: jf %s\n\n", strings.Join(jfCommand, " "))
+		format := c.String("format")
+		if err := validateCompareFormat(format); err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+		isolateHome := c.String("isolate-home")
+		if err := validateIsolateHome(isolateHome); err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		if format == CompareFormatTable {
+			fmt.Printf("🔄 Comparing JFrog CLI versions: %s vs %s\n", config.Version1, config.Version2)
+			fmt.Printf("📝 Command: jf %s\n\n", strings.Join(jfCommand, " "))
+		}
 
 		// Execute commands in parallel
 		results := make([]ExecutionResult, 2)
@@ -160,33 +215,33 @@ var CompareCli = &cli.Command{
 		timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 
-		g.Go(func() error {
-			result, err := executeJFCommand(timeoutCtx, config.Resolved1, jfCommand)
-			results[0] = result
-			return err
-		})
-
-		g.Go(func() error {
-			result, err := executeJFCommand(timeoutCtx, config.Resolved2, jfCommand)
-			results[1] = result
+		// Each version runs once, so per-version and per-run isolation are equivalent here
+		snapshots, cleanup, err := newSnapshotsIfEnabled(isolateHome, 2)
+		if err != nil {
 			return err
-		})
+		}
+		defer cleanup()
+
+		for i, version := range []string{config.Resolved1, config.Resolved2} {
+			g.Go(func() error {
+				result, err := executeJFCommand(timeoutCtx, version, jfCommand, snapshots[i].Env())
+				results[i] = result
+				return err
+			})
+		}
 
 		if err := g.Wait(); err != nil {
 			fmt.Fprintf(os.Stderr, "⚠️  Warning: %v\n\n", err)
 		}
 
-		// Display results
-		displayComparison(results[0], results[1], c.Bool("unified"), c.Bool("no-color"), c.Bool("timing"))
-
-		return nil
+		return renderComparison(c, "cli", results)
 	},
 }
 
 var CompareRt = &cli.Command{
 	Name:      "rt",
-	Usage:     "Compare JFrog CLI command execution between two servers",
-	ArgsUsage: "<server1> <server2> -- <jf-command> [args...]",
+	Usage:     "Compare JFrog CLI command execution between two or more servers",
+	ArgsUsage: "<server1>[@version] <server2>[@version] [server...] -- <jf-command> [args...]",
 	Flags: []cli.Flag{
 		&cli.BoolFlag{
 			Name:  "unified",
@@ -208,51 +263,132 @@ var CompareRt = &cli.Command{
 			Usage: "Show execution timing information",
 			Value: true,
 		},
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "Output format: table, json, markdown, junit",
+			Value: CompareFormatTable,
+		},
+		&cli.BoolFlag{
+			Name:  "fail-on-diff",
+			Usage: "Exit with a non-zero code when exit codes or normalized outputs differ",
+			Value: false,
+		},
+		&cli.StringSliceFlag{
+			Name:  "ignore-pattern",
+			Usage: "Regular expression whose matches are masked before outputs are compared (repeatable)",
+		},
+		&cli.StringFlag{
+			Name:  "isolate-home",
+			Usage: "Run each jf against a temporary copy of the JFrog CLI home (JFROG_CLI_HOME_DIR): per-version or per-run",
+		},
+		&cli.IntFlag{
+			Name:  "max-parallel",
+			Usage: "Maximum number of servers queried at the same time (0 = all)",
+			Value: 0,
+		},
 	},
 	Action: func(c *cli.Context) error {
 		args := c.Args().Slice()
 
 		// Validate RT-specific arguments
-		server1, server2, jfCommand, err := validateRTArguments(args)
+		targets, jfCommand, err := validateRTArguments(args)
 		if err != nil {
-			return cli.Exit("Usage: jfcm compare rt <server1> <server2> -- <jf-command> [args...]", 1)
+			return cli.Exit(fmt.Sprintf("%v\nUsage: jfcm compare rt <server1>[@version] <server2>[@version] [server...] -- <jf-command> [args...]", err), 1)
 		}
 
-		fmt.Printf("🔄 Comparing JFrog CLI command across servers: %s vs %s\n", server1, server2)
-		fmt.Printf("📝 Command: jf %s\n\n", strings.Join(jfCommand, " "))
+		format := c.String("format")
+		if err := validateCompareFormat(format); err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
 
-		// Execute commands against both servers in parallel
-		results := make([]ExecutionResult, 2)
+		isolateHome := c.String("isolate-home")
+		if err := validateIsolateHome(isolateHome); err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		if err := resolveServerTargets(targets); err != nil {
+			return err
+		}
+
+		if format == CompareFormatTable {
+			keys := make([]string, len(targets))
+			for i, target := range targets {
+				keys[i] = target.Key()
+			}
+			fmt.Printf("🔄 Comparing JFrog CLI command across servers: %s\n", strings.Join(keys, " vs "))
+			fmt.Printf("📝 Command: jf %s\n\n", strings.Join(jfCommand, " "))
+		}
+
+		snapshots, cleanup, err := newSnapshotsIfEnabled(isolateHome, len(targets))
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		// Execute commands against all servers in parallel
+		results := make([]ExecutionResult, len(targets))
 		g, ctx := errgroup.WithContext(context.Background())
+		if maxParallel := c.Int("max-parallel"); maxParallel > 0 {
+			g.SetLimit(maxParallel)
+		}
 
 		timeout := time.Duration(c.Int("timeout")) * time.Second
 		timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 
-		g.Go(func() error {
-			result, err := executeJFCommandOnServer(timeoutCtx, server1, jfCommand)
-			results[0] = result
-			return err
-		})
-
-		g.Go(func() error {
-			result, err := executeJFCommandOnServer(timeoutCtx, server2, jfCommand)
-			results[1] = result
-			return err
-		})
+		for i, target := range targets {
+			g.Go(func() error {
+				result, err := executeJFCommandOnServer(timeoutCtx, target, jfCommand, snapshots[i].Env())
+				results[i] = result
+				return err
+			})
+		}
 
 		if err := g.Wait(); err != nil {
 			fmt.Fprintf(os.Stderr, "⚠️  Warning: %v\n\n", err)
 		}
 
-		// Display results
-		displayComparison(results[0], results[1], c.Bool("unified"), c.Bool("no-color"), c.Bool("timing"))
-
-		return nil
+		return renderComparison(c, "rt", results)
 	},
 }
 
+// renderComparison displays or exports the comparison results and applies --fail-on-diff
+func renderComparison(c *cli.Context, kind string, results []ExecutionResult) error {
+	normalizer, err := NewOutputNormalizer(c.StringSlice("ignore-pattern"))
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	report := buildComparisonReport(kind, results, normalizer)
+
+	format := c.String("format")
+	if format == CompareFormatTable {
+		// Every target is compared against the first one, which acts as the baseline
+		for i, result := range results[1:] {
+			if len(results) > 2 {
+				if i > 0 {
+					fmt.Println()
+				}
+				fmt.Printf("🔸 %s vs %s\n", results[0].Version, result.Version)
+			}
+			displayComparison(results[0], result, normalizer, c.Bool("unified"), c.Bool("no-color"), c.Bool("timing"))
+		}
+	} else if err := writeComparisonReport(os.Stdout, report, format); err != nil {
+		return err
+	}
+
+	if c.Bool("fail-on-diff") && report.Differs {
+		return cli.Exit("❌ Comparison found differences in exit codes or outputs", 1)
+	}
+
+	return nil
+}
+
 func handleChangelogComparison(c *cli.Context, version1, version2, resolved1, resolved2 string) error {
+	if c.Int("limit") < 0 {
+		return cli.Exit("❌ --limit must not be negative", 1)
+	}
+
 	fmt.Printf("📖 Comparing Release Notes: %s vs %s\n", version1, version2)
 	fmt.Printf("🔍 Fetching changelog between versions...\n\n")
 
@@ -263,7 +399,7 @@ func handleChangelogComparison(c *cli.Context, version1, version2, resolved1, re
 
 	startTime := time.Now()
 
-	// Call FetchTopReleasesNotes() to get changelog data
+	// Call FetchReleaseNotes() to get changelog data
 	owner := DefaultChangelogOwner
 	repo := DefaultChangelogRepo
 
@@ -277,7 +413,7 @@ func handleChangelogComparison(c *cli.Context, version1, version2, resolved1, re
 		toTag = "v" + toTag
 	}
 
-	releaseNotes, err := FetchTopReleasesNotes(ctx, owner, repo, fromTag, toTag)
+	releaseNotes, err := FetchReleaseNotes(ctx, NewGitHubClient(c.Bool("offline")), owner, repo, fromTag, toTag)
 	fetchDuration := time.Since(startTime)
 
 	if err != nil {
@@ -289,6 +425,30 @@ func handleChangelogComparison(c *cli.Context, version1, version2, resolved1, re
 		releaseNotes[i].Body = FilterReleaseNotes(releaseNotes[i].Body)
 	}
 
+	releaseNotes = selectReleaseNotes(releaseNotes, c.Int("limit"), c.Bool("reverse"))
+
+	term := c.String("grep")
+	if c.Bool("breaking") {
+		matches := findBreakingChanges(releaseNotes)
+		title := "⚠️  Breaking changes and deprecations"
+		if term != "" {
+			matches = filterChangelogMatches(matches, term)
+			title = fmt.Sprintf("⚠️  Breaking changes and deprecations mentioning %q", term)
+		}
+		DisplayChangelogMatches(title, matches, term, len(releaseNotes), version1, version2, c.Bool("no-color"))
+		return nil
+	}
+	if term != "" {
+		matches := searchReleaseNotes(releaseNotes, term)
+		DisplayChangelogMatches(fmt.Sprintf("🔎 Release notes mentioning %q", term), matches, term, len(releaseNotes), version1, version2, c.Bool("no-color"))
+		return nil
+	}
+
+	if c.Bool("consolidated") {
+		DisplayConsolidatedChangelog(releaseNotes, version1, version2, c.Bool("no-color"))
+		return nil
+	}
+
 	// Display the changelog results using the moved display function
 	DisplayChangelogResults(releaseNotes, version1, version2, fetchDuration, c.Bool("no-color"), c.Bool("timing"))
 
@@ -33,8 +33,8 @@ func (cd CommandDescription) Format() string {
 }
 
 var Install = CommandDescription{
-	Usage:       "Install a specific JFrog CLI version",
-	Description: "Downloads and installs the specified version of JFrog CLI from JFrog's public release server.",
+	Usage:       "Install one or more JFrog CLI versions",
+	Description: "Downloads and installs the specified versions of JFrog CLI from JFrog's public release server. 'latest' installs the most recent release. With several versions (or --from-file, one version per line) downloads run in parallel, versions that are already installed are skipped, and a summary table is printed; the command exits with status 1 when any version fails to install.",
 	Examples: []Example{
 		{
 			Command:     "jfcm install 2.74.0",
@@ -44,6 +44,63 @@ var Install = CommandDescription{
 			Command:     "jfcm install latest",
 			Description: "Install the latest available version",
 		},
+		{
+			Command:     "jfcm install 2.55.0 2.60.0 latest",
+			Description: "Install several versions in parallel",
+		},
+		{
+			Command:     "jfcm install --from-file versions.txt --max-parallel 2",
+			Description: "Install the versions listed in a file, two at a time",
+		},
+		{
+			Command:     "jfcm install --platform linux/arm64 2.74.0",
+			Description: "Download the linux-arm64 build into the platform cache without activating it",
+		},
+	},
+}
+
+var Export = CommandDescription{
+	Usage:       "Export installed versions as a portable ~/.jfcm tree",
+	Description: "Writes the given versions (all by default) with their metadata, the aliases pointing at them and the block list to a directory or a .tar.gz/.tgz file shaped like ~/.jfcm, ready to be copied into a container image or another machine. --platform exports the builds cached by 'jfcm install --platform' for another platform; --use writes a config file activating one of the exported versions.",
+	Examples: []Example{
+		{
+			Command:     "jfcm export --platform linux/arm64 --use 2.74.0 -o jfcm-home",
+			Description: "Export the cached linux-arm64 builds to a directory, with 2.74.0 active",
+		},
+		{
+			Command:     "jfcm export 2.74.0 -o jfcm-2.74.0.tar.gz",
+			Description: "Export one host version as a tarball",
+		},
+	},
+}
+
+var Bundle = CommandDescription{
+	Usage:       "Move JFrog CLI versions to air-gapped machines",
+	Description: "'bundle export' writes the given versions for one or more platforms to a .tar.gz/.tgz file together with their metadata, a SHA256SUMS file, a manifest, the aliases pointing at them, the block list and the cached GitHub changelog, downloading builds that are not cached yet. 'bundle import' verifies every checksum and installs the contents on the offline side; versions and aliases that already exist with different content are left alone unless --force is given.",
+	Examples: []Example{
+		{
+			Command:     "jfcm bundle export --versions 2.55.0,2.60.0 --platforms linux-amd64,linux-arm64 -o jfcm-bundle.tar.gz",
+			Description: "Bundle two versions for two platforms",
+		},
+		{
+			Command:     "jfcm bundle import jfcm-bundle.tar.gz",
+			Description: "Verify and install a bundle on the offline machine",
+		},
+	},
+}
+
+var Serve = CommandDescription{
+	Usage:       "Serve installed versions to other jfcm clients over HTTP",
+	Description: "Serves the versions installed on this machine, including builds cached for other platforms with 'jfcm install --platform', in the releases.jfrog.io layout (/v2-jf/<version>/jfrog-cli-<platform>/jf) so other jfcm clients can use it as their mirror-url. Each binary has a .sha256 file next to it and an X-Checksum-Sha256 header, /v2-jf/ lists the versions and /index.json lists every build with its digest and size. Range requests are supported, so interrupted downloads resume.",
+	Examples: []Example{
+		{
+			Command:     "jfcm serve --addr :8089",
+			Description: "Serve the local version store on port 8089",
+		},
+		{
+			Command:     "jfcm settings set mirror-url http://build-cache.lan:8089",
+			Description: "On the other machines, download from the mirror",
+		},
 	},
 }
 
@@ -83,7 +140,7 @@ var List = CommandDescription{
 
 var Remove = CommandDescription{
 	Usage:       "Remove a specific JFrog CLI version",
-	Description: "Removes a specific version of JFrog CLI from your system.",
+	Description: "Removes a specific version of JFrog CLI from your system. Removing the active version or the target of an alias asks for confirmation, or requires --force when not running in a terminal. After removing the active version you are offered another installed version to switch to, and aliases left pointing at missing versions are reported.",
 	Examples: []Example{
 		{
 			Command:     "jfcm remove 2.72.1",
@@ -93,17 +150,25 @@ var Remove = CommandDescription{
 			Command:     "jfcm remove old-dev",
 			Description: "Remove a linked version named 'old-dev'",
 		},
+		{
+			Command:     "jfcm remove 2.74.0 --force",
+			Description: "Remove the active version from a script without confirmation",
+		},
 	},
 }
 
 var Clear = CommandDescription{
 	Usage:       "Remove all installed JFrog CLI versions",
-	Description: "Removes all installed versions of JFrog CLI. This action cannot be undone.",
+	Description: "Removes all installed versions of JFrog CLI. This action cannot be undone. It asks for confirmation, or requires --force when not running in a terminal, unsets the active version and reports aliases left pointing at missing versions.",
 	Examples: []Example{
 		{
 			Command:     "jfcm clear",
 			Description: "Remove all installed versions",
 		},
+		{
+			Command:     "jfcm clear --force",
+			Description: "Remove all installed versions from a script",
+		},
 	},
 }
 
@@ -143,7 +208,7 @@ var Link = CommandDescription{
 
 var Compare = CommandDescription{
 	Usage:       "Compare JFrog CLI versions using subcommands",
-	Description: "Compare JFrog CLI versions with three specialized subcommands: 'changelog' for comparing release notes, 'cli' for comparing command execution outputs between different CLI versions, and 'rt' for comparing command execution outputs between different servers with git-like diff visualization.",
+	Description: "Compare JFrog CLI versions with three specialized subcommands: 'changelog' for comparing release notes, 'cli' for comparing command execution outputs between different CLI versions, and 'rt' for comparing command execution outputs between two or more servers (optionally with a different jf version per server) with git-like diff visualization.",
 	Examples: []Example{
 		{
 			Command:     "jfcm compare changelog v2.75.1 v2.76.0",
@@ -177,6 +242,18 @@ var Compare = CommandDescription{
 			Command:     "jfcm compare changelog v2.74.0 v2.73.0 --no-color",
 			Description: "Compare changelogs without colored output",
 		},
+		{
+			Command:     "jfcm compare changelog --offline 2.74.0 2.77.0",
+			Description: "Compare release notes using only cached data (air-gapped agents)",
+		},
+		{
+			Command:     "jfcm compare changelog --consolidated 2.50.0 2.77.0",
+			Description: "Group every release note in the range by section",
+		},
+		{
+			Command:     "jfcm compare changelog --breaking --grep upload 2.50.0 2.77.0",
+			Description: "Find breaking changes and deprecations that mention uploads",
+		},
 		{
 			Command:     "jfcm compare cli old new -- rt search \"*.jar\" --no-color --timing",
 			Description: "CLI comparison with custom formatting options",
@@ -185,6 +262,22 @@ var Compare = CommandDescription{
 			Command:     "jfcm compare rt main backup -- rt repos show --timeout 60",
 			Description: "Server comparison with custom timeout",
 		},
+		{
+			Command:     "jfcm compare rt old@2.55.0 new@2.60.0 dr@2.60.0 -- rt repos show",
+			Description: "Compare several servers in parallel, each with its own jf version",
+		},
+		{
+			Command:     "jfcm compare cli --isolate-home per-version 2.55.0 2.77.0 -- config show",
+			Description: "Compare without letting newer versions migrate your real ~/.jfrog configuration",
+		},
+		{
+			Command:     "jfcm compare cli --format junit --fail-on-diff 2.74.0 2.77.0 -- config show",
+			Description: "Emit a JUnit report and exit non-zero when the outputs differ",
+		},
+		{
+			Command:     "jfcm compare cli --format json --ignore-pattern '[0-9a-f]{40}' old new -- rt ping",
+			Description: "Export results as JSON, masking volatile values before comparing",
+		},
 	},
 }
 
@@ -208,6 +301,26 @@ var Benchmark = CommandDescription{
 			Command:     "jfcm benchmark 2.74.0,2.73.0 -- rt search \"*.jar\" --format csv",
 			Description: "Export results as CSV",
 		},
+		{
+			Command:     "jfcm benchmark --isolate-home per-run 2.74.0,2.77.0 -- rt ping",
+			Description: "Run every iteration against a fresh copy of the JFrog CLI home",
+		},
+		{
+			Command:     "jfcm benchmark --mode interleaved --warmup 2 --iterations 20 2.74.0,2.77.0 -- rt ping",
+			Description: "Alternate versions every iteration after discarded warmup runs",
+		},
+		{
+			Command:     "jfcm benchmark --save-baseline release 2.74.0 -- rt ping",
+			Description: "Save the results as a named baseline",
+		},
+		{
+			Command:     "jfcm benchmark --compare-baseline release --max-regression 10% 2.77.0 -- rt ping",
+			Description: "Fail when the median time is more than 10% slower than the baseline",
+		},
+		{
+			Command:     "jfcm benchmark run suite.yaml --versions 2.74.0,2.77.0",
+			Description: "Run every command of a YAML or JSON suite and print one combined report",
+		},
 	},
 }
 
@@ -246,6 +359,82 @@ var History = CommandDescription{
 	},
 }
 
+var UpgradeAdvisor = CommandDescription{
+	Usage:       "Show what changes when moving from the current version to a target version",
+	Description: "Summarizes an upgrade from the active version (or the .jfrog-version version) to a target version (default: latest): whether the target is blocked or violates .jfrog-version, the release notes in between with their breaking changes, commands added or removed when both versions are installed, and which commands from your history touch changed areas.",
+	Examples: []Example{
+		{
+			Command:     "jfcm upgrade-advisor",
+			Description: "Review an upgrade from the active version to the latest release",
+		},
+		{
+			Command:     "jfcm upgrade-advisor 2.77.0",
+			Description: "Review an upgrade to a specific version",
+		},
+		{
+			Command:     "jfcm upgrade-advisor --from 2.55.0 prod",
+			Description: "Review an upgrade between explicit versions or aliases",
+		},
+	},
+}
+
+var Settings = CommandDescription{
+	Usage:       "Manage jfcm settings",
+	Description: "Reads and writes jfcm settings stored in ~/.jfcm/settings.json. Environment variables (JFCM_GITHUB_API_URL, JFCM_GITHUB_TOKEN, GITHUB_TOKEN, JFCM_MIRROR_URL) take precedence over stored values.",
+	Examples: []Example{
+		{
+			Command:     "jfcm settings set github-api-url https://github.example.com/api/v3",
+			Description: "Fetch release notes from a GitHub Enterprise mirror",
+		},
+		{
+			Command:     "jfcm settings set github-token <token>",
+			Description: "Authenticate GitHub API requests to avoid rate limits",
+		},
+		{
+			Command:     "jfcm settings set mirror-url https://artifactory.example.com/artifactory/jfrog-cli-remote",
+			Description: "Download JFrog CLI binaries from a mirror with the releases.jfrog.io layout",
+		},
+		{
+			Command:     "jfcm settings list",
+			Description: "Show effective settings and where each value comes from",
+		},
+	},
+}
+
+var Prune = CommandDescription{
+	Usage:       "Remove installed versions that are old or no longer used",
+	Description: "Removes installed versions selected by --keep-latest (versions older than the N newest) and --unused-for (versions not used for the given time, based on history and last-used metadata). When both are given, a version must match both. The active version is never removed; --keep-aliased and --keep-pinned protect versions referenced by aliases or by .jfrog-version files under the given paths.",
+	Examples: []Example{
+		{
+			Command:     "jfcm prune --keep-latest 3 --dry-run",
+			Description: "Show which versions outside the three newest would be removed and the space reclaimed",
+		},
+		{
+			Command:     "jfcm prune --unused-for 30d --keep-aliased",
+			Description: "Remove versions unused for 30 days, except aliased ones",
+		},
+		{
+			Command:     "jfcm prune --keep-latest 2 --keep-pinned ~/src --keep-pinned ~/work",
+			Description: "Keep the two newest versions and every version pinned by a project under ~/src or ~/work",
+		},
+	},
+}
+
+var Verify = CommandDescription{
+	Usage:       "Check installed binaries against the digests recorded at install time",
+	Description: "Re-hashes ~/.jfcm/versions/<version>/jf for every installed version (or the versions and aliases given) and compares it with the SHA-256 digest and size recorded in meta.json when the version was installed or linked; versions installed before metadata was recorded get their current digest recorded. Detects missing, empty, truncated, modified and non-executable binaries, and binaries that fail to run --version or report a different version than their directory. Exits with status 1 when a version fails verification.",
+	Examples: []Example{
+		{
+			Command:     "jfcm verify",
+			Description: "Verify every installed version",
+		},
+		{
+			Command:     "jfcm verify 2.74.0 --reinstall",
+			Description: "Verify one version and download it again if it is corrupted",
+		},
+	},
+}
+
 var Version = CommandDescription{
 	Usage:       "Show jfcm version information",
 	Description: "Displays detailed version information including build date, git commit, and platform details.",
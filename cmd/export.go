@@ -0,0 +1,245 @@
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/descriptions"
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+	"github.com/urfave/cli/v2"
+)
+
+var Export = &cli.Command{
+	Name:        "export",
+	Usage:       descriptions.Export.Usage,
+	ArgsUsage:   "[versions...]",
+	Description: descriptions.Export.Format(),
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "platform",
+			Usage: "Export the builds cached for this platform (e.g. linux/arm64) instead of the host's",
+		},
+		&cli.StringFlag{
+			Name:     "output",
+			Aliases:  []string{"o"},
+			Usage:    "Directory to write, or a .tar.gz/.tgz file",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "use",
+			Usage: "Version to make active in the exported tree",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		platform, err := installPlatform(c.String("platform"))
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("❌ %v", err), 1)
+		}
+		versionsDir := utils.PlatformVersionsDir(platform.Name())
+
+		available, err := versionDirsIn(versionsDir)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", versionsDir, err)
+		}
+		versions := c.Args().Slice()
+		if len(versions) == 0 {
+			versions = available
+		}
+		if len(versions) == 0 {
+			return cli.Exit(fmt.Sprintf("❌ No versions installed for %s; run 'jfcm install --platform %s/%s <version>' first",
+				platform.Name(), platform.GOOS, platform.GOARCH), 1)
+		}
+		for _, version := range versions {
+			if !slices.Contains(available, version) {
+				return cli.Exit(fmt.Sprintf("❌ Version %s is not installed for %s", version, platform.Name()), 1)
+			}
+		}
+		active := c.String("use")
+		if active != "" && !slices.Contains(versions, active) {
+			return cli.Exit(fmt.Sprintf("❌ --use %s is not one of the exported versions", active), 1)
+		}
+
+		files, err := planExport(versionsDir, versions, active)
+		if err != nil {
+			return err
+		}
+
+		output := c.String("output")
+		if isTarballPath(output) {
+			err = writeExportTarball(output, files)
+		} else {
+			err = writeExportDir(output, files)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write %s: %w", output, err)
+		}
+
+		fmt.Printf("✅ Exported %s for %s to %s\n", strings.Join(versions, ", "), platform.Name(), output)
+		if isTarballPath(output) {
+			fmt.Printf("💡 Unpack it on the target with: mkdir -p ~/.jfcm && tar -xzf %s -C ~/.jfcm\n", filepath.Base(output))
+		} else {
+			fmt.Printf("💡 Copy it to ~/.jfcm on the target, e.g. COPY %s /root/.jfcm\n", filepath.Base(output))
+		}
+		if active != "" {
+			fmt.Printf("💡 Run 'jfcm use %s' on the target to set up the shim\n", active)
+		}
+		return nil
+	},
+}
+
+// exportFile is one file of an exported ~/.jfcm tree
+type exportFile struct {
+	Name   string // slash-separated path relative to the tree root
+	Source string // file to copy; Data is written when empty
+	Data   []byte
+	Mode   os.FileMode
+}
+
+// planExport lists the files of a ~/.jfcm tree holding versions from versionsDir, the aliases pointing
+// at them, the block list and, when active is set, a config file activating it
+func planExport(versionsDir string, versions []string, active string) ([]exportFile, error) {
+	var files []exportFile
+	for _, version := range versions {
+		binPath := filepath.Join(versionsDir, version, utils.BinaryName)
+		if _, err := os.Stat(binPath); err != nil {
+			return nil, fmt.Errorf("version %s has no binary: %w", version, err)
+		}
+		files = append(files, exportFile{Name: path.Join(utils.VersionsDir, version, utils.BinaryName), Source: binPath, Mode: 0755})
+
+		// Versions without metadata get it backfilled on the target
+		metaPath := filepath.Join(versionsDir, version, utils.MetaFileName)
+		if _, err := os.Stat(metaPath); err == nil {
+			files = append(files, exportFile{Name: path.Join(utils.VersionsDir, version, utils.MetaFileName), Source: metaPath, Mode: 0644})
+		}
+	}
+
+	aliased, err := aliasedVersions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read aliases: %w", err)
+	}
+	for _, version := range versions {
+		for _, alias := range aliased[version] {
+			files = append(files, exportFile{Name: path.Join(utils.AliasesDir, alias), Source: filepath.Join(utils.JFCMAliases, alias), Mode: 0644})
+		}
+	}
+
+	if _, err := os.Stat(utils.JFCMBlockFile); err == nil {
+		files = append(files, exportFile{Name: utils.BlockFile, Source: utils.JFCMBlockFile, Mode: 0644})
+	}
+	if active != "" {
+		files = append(files, exportFile{Name: utils.ConfigFile, Data: []byte(active), Mode: 0644})
+	}
+	return files, nil
+}
+
+// isTarballPath reports whether an export should be written as a gzipped tarball
+func isTarballPath(output string) bool {
+	return strings.HasSuffix(output, ".tar.gz") || strings.HasSuffix(output, ".tgz")
+}
+
+// open returns the content of an export file
+func (f exportFile) open() (io.ReadCloser, int64, error) {
+	if f.Source == "" {
+		return io.NopCloser(strings.NewReader(string(f.Data))), int64(len(f.Data)), nil
+	}
+	file, err := os.Open(f.Source)
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, err
+	}
+	return file, info.Size(), nil
+}
+
+// writeExportDir writes the files under dir
+func writeExportDir(dir string, files []exportFile) error {
+	for _, f := range files {
+		target := filepath.Join(dir, filepath.FromSlash(f.Name))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		if f.Source != "" {
+			if err := utils.CopyFile(f.Source, target); err != nil {
+				return err
+			}
+			if err := os.Chmod(target, f.Mode); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := os.WriteFile(target, f.Data, f.Mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeExportTarball writes the files to a gzipped tarball whose entries are relative to ~/.jfcm
+func writeExportTarball(output string, files []exportFile) error {
+	if dir := filepath.Dir(output); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	tmpPath := output + ".tmp"
+	out, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+	modTime := time.Now()
+	for _, f := range files {
+		if err := writeTarEntry(tw, f, modTime); err != nil {
+			out.Close()
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		out.Close()
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, output)
+}
+
+// writeTarEntry appends one file to a tarball
+func writeTarEntry(tw *tar.Writer, f exportFile, modTime time.Time) error {
+	content, size, err := f.open()
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	header := &tar.Header{
+		Name:     f.Name,
+		Mode:     int64(f.Mode.Perm()),
+		Size:     size,
+		ModTime:  modTime,
+		Typeflag: tar.TypeReg,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err = io.CopyN(tw, content, size)
+	return err
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+)
+
+func TestExportTarball(t *testing.T) {
+	root := t.TempDir()
+	oldAliases, oldBlock := utils.JFCMAliases, utils.JFCMBlockFile
+	utils.JFCMAliases, utils.JFCMBlockFile = filepath.Join(root, "aliases"), filepath.Join(root, "blocked-versions")
+	defer func() { utils.JFCMAliases, utils.JFCMBlockFile = oldAliases, oldBlock }()
+
+	versionsDir := filepath.Join(root, "platforms", "linux-arm64", "versions")
+	for _, version := range []string{"2.50.0", "2.51.0"} {
+		dir := filepath.Join(versionsDir, version)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, utils.BinaryName), []byte("jf "+version), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := utils.WriteVersionMetaIn(versionsDir, "2.51.0", utils.VersionMeta{Platform: "linux-arm64", Installer: utils.InstallerDownload}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(utils.JFCMAliases, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for alias, version := range map[string]string{"prod": "2.51.0", "legacy": "2.40.0"} {
+		if err := os.WriteFile(filepath.Join(utils.JFCMAliases, alias), []byte(`{"version":"`+version+`"}`), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := planExport(versionsDir, []string{"2.50.0", "2.51.0"}, "2.51.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := filepath.Join(root, "out", "jfcm.tar.gz")
+	if err := writeExportTarball(output, files); err != nil {
+		t.Fatal(err)
+	}
+
+	entries := readTarball(t, output)
+	expected := map[string]int64{
+		"versions/2.50.0/jf":        0755,
+		"versions/2.51.0/jf":        0755,
+		"versions/2.51.0/meta.json": 0644,
+		"aliases/prod":              0644,
+		"config":                    0644,
+	}
+	modes := make(map[string]int64)
+	for name, entry := range entries {
+		modes[name] = entry.mode
+	}
+	if !reflect.DeepEqual(modes, expected) {
+		t.Errorf("expected entries %v, got %v", expected, modes)
+	}
+	if got := entries["config"].content; got != "2.51.0" {
+		t.Errorf("expected config to activate 2.51.0, got %q", got)
+	}
+	if got := entries["versions/2.50.0/jf"].content; got != "jf 2.50.0" {
+		t.Errorf("expected the binary content to be copied, got %q", got)
+	}
+}
+
+type tarEntry struct {
+	mode    int64
+	content string
+}
+
+// readTarball returns the entries of a gzipped tarball by name
+func readTarball(t *testing.T, path string) map[string]tarEntry {
+	t.Helper()
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+
+	entries := make(map[string]tarEntry)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries[header.Name] = tarEntry{mode: header.Mode, content: string(content)}
+	}
+	return entries
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+)
+
+// GitHubCacheDir is the directory under the jfcm cache holding GitHub API responses
+const GitHubCacheDir = "github"
+
+// GitHubClient performs GitHub API requests through an on-disk response cache.
+// Cached responses are revalidated with their ETag, reused when the API is unreachable
+// and are the only data source in offline mode.
+type GitHubClient struct {
+	BaseURL  string
+	Token    string
+	Offline  bool
+	CacheDir string
+	HTTP     *http.Client
+
+	fallbackWarning sync.Once
+}
+
+// gitHubResponse is the part of an API response the changelog code relies on
+type gitHubResponse struct {
+	Body []byte
+	Link string
+}
+
+// cachedGitHubResponse is the on-disk representation of a cached API response
+type cachedGitHubResponse struct {
+	Path      string          `json:"path"`
+	ETag      string          `json:"etag,omitempty"`
+	Link      string          `json:"link,omitempty"`
+	FetchedAt time.Time       `json:"fetched_at"`
+	Body      json.RawMessage `json:"body"`
+}
+
+// GitHubStatusError is returned for non-2xx API responses
+type GitHubStatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *GitHubStatusError) Error() string {
+	if e.StatusCode == http.StatusForbidden {
+		if strings.Contains(e.Body, "rate limit") {
+			return "GitHub API rate limit exceeded. Please wait and try again, or authenticate your requests"
+		}
+		return fmt.Sprintf("GitHub API access forbidden: %s", e.Body)
+	}
+	return fmt.Sprintf("GitHub API error %d: %s", e.StatusCode, e.Body)
+}
+
+// NewGitHubClient creates a client using the configured API base URL, token and cache location
+func NewGitHubClient(offline bool) *GitHubClient {
+	return &GitHubClient{
+		BaseURL:  utils.GitHubAPIBaseURL(),
+		Token:    utils.GitHubToken(),
+		Offline:  offline,
+		CacheDir: filepath.Join(utils.JFCMCache, GitHubCacheDir),
+		HTTP:     httpClient,
+	}
+}
+
+// get fetches an API path such as /repos/jfrog/jfrog-cli/releases?page=1
+func (c *GitHubClient) get(ctx context.Context, path string) (*gitHubResponse, error) {
+	cached := c.readCache(path)
+
+	if c.Offline {
+		if cached == nil {
+			return nil, fmt.Errorf("%s is not cached; run once without --offline to populate the cache", path)
+		}
+		return &gitHubResponse{Body: cached.Body, Link: cached.Link}, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.BaseURL+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", UserAgent)
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	if c.Token != "" {
+		req.Header.Set("Authorization", "token "+c.Token)
+	}
+	if cached != nil && cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		if cached != nil && ctx.Err() == nil {
+			c.fallbackWarning.Do(func() {
+				fmt.Fprintf(os.Stderr, "⚠️  GitHub API unreachable (%v), using cached release data\n", err)
+			})
+			return &gitHubResponse{Body: cached.Body, Link: cached.Link}, nil
+		}
+		return nil, fmt.Errorf("request to %s failed: %w", c.BaseURL+path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		return &gitHubResponse{Body: cached.Body, Link: cached.Link}, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &GitHubStatusError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	result := &gitHubResponse{Body: body, Link: resp.Header.Get("Link")}
+	if json.Valid(body) {
+		c.writeCache(&cachedGitHubResponse{
+			Path:      path,
+			ETag:      resp.Header.Get("ETag"),
+			Link:      result.Link,
+			FetchedAt: time.Now(),
+			Body:      body,
+		})
+	}
+	return result, nil
+}
+
+// cacheFile returns the cache location of an API path. Entries are keyed by path only
+// so a cache populated from api.github.com also serves a GitHub Enterprise mirror.
+func (c *GitHubClient) cacheFile(path string) string {
+	sum := sha256.Sum256([]byte(path))
+	return filepath.Join(c.CacheDir, hex.EncodeToString(sum[:])+".json")
+}
+
+// readCache returns the cached response of a path, or nil when there is none
+func (c *GitHubClient) readCache(path string) *cachedGitHubResponse {
+	if c.CacheDir == "" {
+		return nil
+	}
+	data, err := os.ReadFile(c.cacheFile(path))
+	if err != nil {
+		return nil
+	}
+	var entry cachedGitHubResponse
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Path != path {
+		return nil
+	}
+	return &entry
+}
+
+// writeCache stores a response. Failures only cost a refetch, so they are not reported.
+func (c *GitHubClient) writeCache(entry *cachedGitHubResponse) {
+	if c.CacheDir == "" {
+		return
+	}
+	if err := os.MkdirAll(c.CacheDir, 0755); err != nil {
+		return
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	_ = utils.WriteFileAtomic(c.cacheFile(entry.Path), data, 0644)
+}
+
+// isGitHubNotFound reports whether err is a 404 API response
+func isGitHubNotFound(err error) bool {
+	var statusErr *GitHubStatusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound
+}
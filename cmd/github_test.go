@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newFakeReleasesServer serves a minimal GitHub releases API with ETag support
+func newFakeReleasesServer(t *testing.T, releases []GitHubRelease) (*httptest.Server, *int32, *int32) {
+	t.Helper()
+	var requests, notModified int32
+
+	mux := http.NewServeMux()
+	serve := func(w http.ResponseWriter, r *http.Request, v interface{}) {
+		atomic.AddInt32(&requests, 1)
+		body, _ := json.Marshal(v)
+		etag := `"` + r.URL.RequestURI() + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Write(body)
+	}
+	mux.HandleFunc("/repos/jfrog/jfrog-cli/releases", func(w http.ResponseWriter, r *http.Request) {
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if perPage <= 0 || page <= 0 {
+			http.Error(w, "bad paging", http.StatusBadRequest)
+			return
+		}
+		lastPage := (len(releases) + perPage - 1) / perPage
+		if lastPage > 1 {
+			w.Header().Set("Link", fmt.Sprintf(`<%s?per_page=%d&page=%d>; rel="last"`, r.URL.Path, perPage, lastPage))
+		}
+		start, end := (page-1)*perPage, page*perPage
+		if start > len(releases) {
+			start = len(releases)
+		}
+		if end > len(releases) {
+			end = len(releases)
+		}
+		serve(w, r, releases[start:end])
+	})
+	mux.HandleFunc("/repos/jfrog/jfrog-cli/releases/tags/", func(w http.ResponseWriter, r *http.Request) {
+		tag := strings.TrimPrefix(r.URL.Path, "/repos/jfrog/jfrog-cli/releases/tags/")
+		for _, rel := range releases {
+			if rel.TagName == tag {
+				serve(w, r, rel)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &requests, &notModified
+}
+
+func TestFetchReleaseNotesWithCache(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	releases := []GitHubRelease{
+		{TagName: "v2.3.0", Name: "2.3.0", Body: "three", PublishedAt: base.Add(72 * time.Hour)},
+		{TagName: "v2.2.0", Name: "2.2.0", Body: "two", PublishedAt: base.Add(48 * time.Hour)},
+		{TagName: "v2.1.0", Name: "2.1.0", Body: "one", PublishedAt: base.Add(24 * time.Hour)},
+	}
+	server, requests, notModified := newFakeReleasesServer(t, releases)
+
+	client := &GitHubClient{BaseURL: server.URL, CacheDir: t.TempDir(), HTTP: server.Client()}
+	ctx := context.Background()
+
+	notes, err := FetchReleaseNotes(ctx, client, "jfrog", "jfrog-cli", "v2.1.0", "v2.3.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(notes) != 2 || notes[0].Tag != "v2.2.0" || notes[1].Tag != "v2.3.0" {
+		t.Fatalf("unexpected notes: %+v", notes)
+	}
+
+	// A second run revalidates every cached response instead of downloading it again
+	requestsBefore, notModifiedBefore := atomic.LoadInt32(requests), atomic.LoadInt32(notModified)
+	if _, err := FetchReleaseNotes(ctx, client, "jfrog", "jfrog-cli", "v2.1.0", "v2.3.0"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sent := atomic.LoadInt32(requests) - requestsBefore
+	if revalidated := atomic.LoadInt32(notModified) - notModifiedBefore; sent == 0 || revalidated != sent {
+		t.Errorf("expected all %d requests to be answered with 304, got %d", sent, revalidated)
+	}
+
+	// Offline mode never contacts the server and serves the cached data
+	server.Close()
+	offline := &GitHubClient{BaseURL: server.URL, CacheDir: client.CacheDir, Offline: true, HTTP: server.Client()}
+	notes, err = FetchReleaseNotes(ctx, offline, "jfrog", "jfrog-cli", "v2.1.0", "v2.3.0")
+	if err != nil {
+		t.Fatalf("unexpected error in offline mode: %v", err)
+	}
+	if len(notes) != 2 {
+		t.Errorf("expected 2 cached notes, got %d", len(notes))
+	}
+
+	if _, err := FetchReleaseNotes(ctx, offline, "jfrog", "jfrog-cli", "v2.0.0", "v2.3.0"); err == nil ||
+		!strings.Contains(err.Error(), "not cached") {
+		t.Errorf("expected a not cached error for an uncached range, got %v", err)
+	}
+}
+
+func TestGitHubClientNotFound(t *testing.T) {
+	server, _, _ := newFakeReleasesServer(t, nil)
+	client := &GitHubClient{BaseURL: server.URL, CacheDir: t.TempDir(), HTTP: server.Client()}
+
+	_, err := getReleaseByTag(context.Background(), client, "jfrog", "jfrog-cli", "v9.9.9")
+	if err == nil || !strings.Contains(err.Error(), "release not found") {
+		t.Errorf("expected release not found error, got %v", err)
+	}
+}
+
+func TestFetchReleaseNotesFullRange(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var releases []GitHubRelease
+	// Newest first, as the API returns them, spanning three pages
+	for i := 250; i >= 1; i-- {
+		releases = append(releases, GitHubRelease{
+			TagName:     fmt.Sprintf("v2.%d.0", i),
+			Name:        fmt.Sprintf("2.%d.0", i),
+			PublishedAt: base.Add(time.Duration(i) * time.Hour),
+			Prerelease:  i == 120,
+			Draft:       i == 121,
+		})
+	}
+	server, _, _ := newFakeReleasesServer(t, releases)
+	client := &GitHubClient{BaseURL: server.URL, CacheDir: t.TempDir(), HTTP: server.Client()}
+
+	notes, err := FetchReleaseNotes(context.Background(), client, "jfrog", "jfrog-cli", "v2.50.0", "v2.180.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 2.51.0 through 2.180.0 minus one draft and one prerelease
+	if len(notes) != 128 {
+		t.Fatalf("expected 128 releases, got %d", len(notes))
+	}
+	if notes[0].Tag != "v2.51.0" || notes[len(notes)-1].Tag != "v2.180.0" {
+		t.Errorf("unexpected range boundaries %s..%s", notes[0].Tag, notes[len(notes)-1].Tag)
+	}
+	for _, note := range notes {
+		if note.Tag == "v2.120.0" || note.Tag == "v2.121.0" {
+			t.Errorf("draft or prerelease %s should be skipped", note.Tag)
+		}
+	}
+
+	limited := selectReleaseNotes(notes, 3, true)
+	if len(limited) != 3 || limited[0].Tag != "v2.180.0" || limited[2].Tag != "v2.178.0" {
+		t.Errorf("unexpected limited selection: %+v", limited)
+	}
+}
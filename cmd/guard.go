@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+)
+
+// stdinReader is shared by prompts so buffered input is not lost between them
+var stdinReader = bufio.NewReader(os.Stdin)
+
+// isInteractive reports whether stdin is a terminal a user can answer prompts on
+func isInteractive() bool {
+	fd := os.Stdin.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
+
+// promptLine prints a prompt and returns the trimmed answer; ok is false when input ended
+// before a line was entered
+func promptLine(prompt string) (answer string, ok bool) {
+	fmt.Print(prompt)
+	line, err := stdinReader.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		fmt.Println()
+		return "", false
+	}
+	return strings.TrimSpace(line), true
+}
+
+// confirm asks a yes/no question; anything but y or yes is a no
+func confirm(question string) bool {
+	answer, _ := promptLine(question + " [y/N]: ")
+	answer = strings.ToLower(answer)
+	return answer == "y" || answer == "yes"
+}
+
+// VersionGuard describes why removing a version needs confirmation
+type VersionGuard struct {
+	Version string
+	Active  bool
+	Aliases []string
+}
+
+// Warnings returns one line per reason the version is in use
+func (g VersionGuard) Warnings() []string {
+	var warnings []string
+	if g.Active {
+		warnings = append(warnings, fmt.Sprintf("%s is the active version", g.Version))
+	}
+	if len(g.Aliases) > 0 {
+		warnings = append(warnings, fmt.Sprintf("%s is the target of aliases: %s", g.Version, strings.Join(g.Aliases, ", ")))
+	}
+	return warnings
+}
+
+// versionGuards returns the guards of the versions that are active or aliased
+func versionGuards(versions []string) ([]VersionGuard, error) {
+	active, _ := utils.GetActiveVersion()
+	aliased, err := aliasedVersions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read aliases: %w", err)
+	}
+
+	var guards []VersionGuard
+	for _, version := range versions {
+		guard := VersionGuard{Version: version, Active: version == active, Aliases: aliased[version]}
+		sort.Strings(guard.Aliases)
+		if guard.Active || len(guard.Aliases) > 0 {
+			guards = append(guards, guard)
+		}
+	}
+	return guards, nil
+}
+
+// confirmRemoval asks before a destructive action. With --force it proceeds; without a terminal
+// to ask on it refuses and tells the user to pass --force.
+func confirmRemoval(warnings []string, question string, force bool) error {
+	if len(warnings) == 0 || force {
+		return nil
+	}
+	for _, warning := range warnings {
+		fmt.Printf("⚠️  %s\n", warning)
+	}
+	if !isInteractive() {
+		return fmt.Errorf("❌ refusing to continue without confirmation; re-run with --force")
+	}
+	if !confirm(question) {
+		return fmt.Errorf("❌ aborted")
+	}
+	return nil
+}
+
+// offerSwitch is called after the active version was removed. Interactively it offers to
+// activate another installed version; otherwise, or when declined, the active version is unset
+// so the shim reports that no version is active.
+func offerSwitch(removed string) error {
+	installed, err := utils.GetInstalledVersions()
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if len(installed) > 0 && isInteractive() {
+		fallback := installed[len(installed)-1]
+		fmt.Printf("📦 Installed versions: %s\n", strings.Join(installed, ", "))
+		answer, ok := promptLine(fmt.Sprintf("Switch to another version? [%s, or 'n' for none]: ", fallback))
+		declined := strings.EqualFold(answer, "n") || strings.EqualFold(answer, "no")
+		if ok && !declined {
+			version := answer
+			if version == "" {
+				version = fallback
+			}
+			if err := utils.SwitchToVersion(version); err != nil {
+				return fmt.Errorf("failed to switch to %s: %w", version, err)
+			}
+			fmt.Printf("✅ Switched to %s\n", version)
+			return nil
+		}
+	}
+
+	if err := os.Remove(utils.JFCMConfig); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to unset the active version: %w", err)
+	}
+	fmt.Printf("⚠️  %s was the active version; no version is active now\n", removed)
+	if len(installed) > 0 {
+		fmt.Printf("💡 Run 'jfcm use <version>' to activate one of: %s\n", strings.Join(installed, ", "))
+	} else {
+		fmt.Println("💡 Run 'jfcm install <version>' and 'jfcm use <version>' to activate a version")
+	}
+	return nil
+}
+
+// reportDanglingAliases warns about aliases pointing at versions that are not installed
+func reportDanglingAliases() {
+	aliased, err := aliasedVersions()
+	if err != nil {
+		return
+	}
+
+	var dangling []string
+	for version, aliases := range aliased {
+		if utils.CheckVersionExists(version) == nil {
+			continue
+		}
+		for _, alias := range aliases {
+			dangling = append(dangling, fmt.Sprintf("%s → %s", alias, version))
+		}
+	}
+	if len(dangling) == 0 {
+		return
+	}
+	sort.Strings(dangling)
+	fmt.Printf("⚠️  Aliases pointing at versions that are not installed: %s\n", strings.Join(dangling, ", "))
+	fmt.Println("   Update them with 'jfcm alias set' or delete them with 'jfcm alias remove'")
+}
+
+// withStateLock runs a command while holding the jfcm state lock, so concurrent jfcm commands
+// do not interleave their changes to the config file, aliases, the block file and settings
+func withStateLock(action cli.ActionFunc) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		stateLock, err := utils.LockState()
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("❌ %v", err), 1)
+		}
+		defer stateLock.Release()
+		return action(c)
+	}
+}
+
+// errChangeNotConfirmed is returned for a fix that changes the shell profile when nobody confirmed it
+var errChangeNotConfirmed = errors.New("refusing to change the shell profile without confirmation; re-run with --yes")
+
+// confirmChange asks before a fix changes files outside ~/.jfcm. With --yes it proceeds; without
+// a terminal to ask on it refuses and tells the user to pass --yes.
+func confirmChange(question string, yes bool) error {
+	if yes {
+		return nil
+	}
+	if !isInteractive() {
+		return errChangeNotConfirmed
+	}
+	if !confirm(question) {
+		return errors.New("declined")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+)
+
+func TestVersionGuards(t *testing.T) {
+	root := t.TempDir()
+	oldConfig, oldAliases := utils.JFCMConfig, utils.JFCMAliases
+	utils.JFCMConfig, utils.JFCMAliases = filepath.Join(root, "config"), filepath.Join(root, "aliases")
+	defer func() { utils.JFCMConfig, utils.JFCMAliases = oldConfig, oldAliases }()
+
+	if err := os.WriteFile(utils.JFCMConfig, []byte("2.50.0"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(utils.JFCMAliases, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for alias, content := range map[string]string{
+		"prod":   `{"version":"2.51.0"}`,
+		"stable": `{"version":"2.51.0","description":"legacy"}`,
+		"old":    "2.40.0", // legacy plain-text alias
+	} {
+		if err := os.WriteFile(filepath.Join(utils.JFCMAliases, alias), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	guards, err := versionGuards([]string{"2.50.0", "2.51.0", "2.52.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []VersionGuard{
+		{Version: "2.50.0", Active: true},
+		{Version: "2.51.0", Aliases: []string{"prod", "stable"}},
+	}
+	if !reflect.DeepEqual(guards, expected) {
+		t.Errorf("expected %+v, got %+v", expected, guards)
+	}
+
+	// Tests do not run on a terminal, so guarded removals need --force
+	if err := confirmRemoval(guards[0].Warnings(), "Remove?", false); err == nil {
+		t.Errorf("expected a non-interactive removal to be refused")
+	}
+	if err := confirmRemoval(guards[0].Warnings(), "Remove?", true); err != nil {
+		t.Errorf("expected --force to skip confirmation, got %v", err)
+	}
+	if err := confirmRemoval(nil, "Remove?", false); err != nil {
+		t.Errorf("expected unguarded removals to proceed, got %v", err)
+	}
+}
@@ -195,7 +195,7 @@ func saveHistory(historyFile string, entries []HistoryEntry) error {
 		return err
 	}
 
-	return os.WriteFile(historyFile, data, 0644)
+	return utils.WriteFileAtomic(historyFile, data, 0644)
 }
 
 func AddHistoryEntry(version, command string, duration time.Duration, exitCode int, stdout, stderr string) {
@@ -206,6 +206,13 @@ func AddHistoryEntry(version, command string, duration time.Duration, exitCode i
 
 	historyFile := filepath.Join(utils.JFCMRoot, "history.json")
 
+	// The shim records history in the background, so several writers can race on the file
+	historyLock, err := utils.LockHistory()
+	if err != nil {
+		return
+	}
+	defer historyLock.Release()
+
 	entries, err := loadHistory(historyFile)
 	if err != nil && !os.IsNotExist(err) {
 		return
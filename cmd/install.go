@@ -1,22 +1,259 @@
 package cmd
 
 import (
+	"bufio"
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
 
+	"github.com/jfrog/jfrog-cli-manager/cmd/descriptions"
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
 	"github.com/jfrog/jfrog-cli-manager/internal"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
 	"github.com/urfave/cli/v2"
+	"golang.org/x/sync/errgroup"
 )
 
+// Outcomes of installing a version
+const (
+	InstallInstalled = "installed"
+	InstallSkipped   = "already installed"
+	InstallFailed    = "failed"
+)
+
+// defaultInstallParallel is how many versions are downloaded at the same time
+const defaultInstallParallel = 4
+
 var Install = &cli.Command{
-	Name:      "install",
-	Usage:     "Install a specific version of JFrog CLI",
-	ArgsUsage: "[version]",
+	Name:        "install",
+	Usage:       descriptions.Install.Usage,
+	ArgsUsage:   "<version|latest>...",
+	Description: descriptions.Install.Format(),
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "from-file",
+			Usage: "Read versions from a file, one per line ('#' starts a comment)",
+		},
+		&cli.StringFlag{
+			Name:  "platform",
+			Usage: "Download builds for another platform (e.g. linux/arm64) into a cache without activating them",
+		},
+		&cli.IntFlag{
+			Name:  "max-parallel",
+			Usage: "Maximum number of concurrent downloads",
+			Value: defaultInstallParallel,
+		},
+	},
 	Action: func(c *cli.Context) error {
-		if c.Args().Len() != 1 {
-			return cli.Exit("Please provide a version (e.g., 2.57.0)", 1)
+		requested := c.Args().Slice()
+		if path := c.String("from-file"); path != "" {
+			fromFile, err := readVersionsFile(path)
+			if err != nil {
+				return cli.Exit(fmt.Sprintf("❌ %v", err), 1)
+			}
+			requested = append(requested, fromFile...)
+		}
+		if len(requested) == 0 {
+			return cli.Exit("Please provide a version (e.g., 2.57.0) or --from-file", 1)
+		}
+		maxParallel := c.Int("max-parallel")
+		if maxParallel < 1 {
+			return cli.Exit("--max-parallel must be at least 1", 1)
+		}
+
+		platform, err := installPlatform(c.String("platform"))
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("❌ %v", err), 1)
+		}
+		crossPlatform := platform.Name() != utils.HostPlatform()
+
+		versions, err := resolveInstallVersions(requested)
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("❌ %v", err), 1)
+		}
+
+		downloader := internal.NewDownloader(os.Stderr)
+		install := func(version string) error {
+			ctx, cancel := context.WithTimeout(context.Background(), internal.DownloadTimeout)
+			defer cancel()
+			return internal.DownloadAndInstallPlatform(ctx, version, platform, downloader)
+		}
+
+		if len(versions) == 1 {
+			if versionInstalled(utils.PlatformVersionsDir(platform.Name()), versions[0]) {
+				fmt.Printf("✅ Version %s is already installed\n", versions[0])
+				fmt.Println("💡 Run 'jfcm verify --reinstall' to download a corrupted version again")
+			} else {
+				fmt.Printf("Installing JFrog CLI version: %s\n", versions[0])
+				if err := install(versions[0]); err != nil {
+					return err
+				}
+			}
+		} else {
+			fmt.Printf("📦 Installing %d JFrog CLI versions: %s\n", len(versions), strings.Join(versions, ", "))
+			// Progress bars of concurrent downloads would overwrite each other
+			downloader.NewProgress = func(label string) internal.ProgressReporter {
+				return internal.NewTextProgressReporter(os.Stderr, label)
+			}
+			results := installVersions(utils.PlatformVersionsDir(platform.Name()), versions, maxParallel, install)
+
+			displayInstallResults(results)
+			if failed := countInstallFailures(results); failed > 0 {
+				return cli.Exit(fmt.Sprintf("❌ %d of %d versions failed to install", failed, len(results)), 1)
+			}
+		}
+
+		if crossPlatform {
+			fmt.Printf("📦 Cached %s builds in %s\n", platform.Name(), utils.PlatformVersionsDir(platform.Name()))
+			fmt.Printf("💡 Run 'jfcm export --platform %s/%s --output <dir or .tar.gz>' to package them\n", platform.GOOS, platform.GOARCH)
 		}
-		version := c.Args().Get(0)
-		fmt.Printf("Installing JFrog CLI version: %s\n", version)
-		return internal.DownloadAndInstall(version)
+		return nil
 	},
 }
+
+// installPlatform returns the platform given with --platform, or the host platform
+func installPlatform(value string) (internal.Platform, error) {
+	if value == "" {
+		return internal.LookupPlatform(runtime.GOOS, runtime.GOARCH)
+	}
+	return internal.ParsePlatform(value)
+}
+
+// InstallResult is the outcome of installing one version
+type InstallResult struct {
+	Version  string
+	Status   string
+	Duration time.Duration
+	Err      error
+}
+
+// readVersionsFile reads one version per line, skipping blank lines and '#' comments. Lines that
+// are neither a version nor "latest" are reported with their line number.
+func readVersionsFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read versions file: %w", err)
+	}
+	defer file.Close()
+
+	var versions []string
+	scanner := bufio.NewScanner(file)
+	for number := 1; scanner.Scan(); number++ {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		if !strings.EqualFold(line, "latest") {
+			if err := utils.ValidateVersionName(line); err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, number, err)
+			}
+		}
+		versions = append(versions, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read versions file: %w", err)
+	}
+	return versions, nil
+}
+
+// resolveInstallVersions replaces "latest" with the latest release, rejects names that are not
+// versions and drops duplicates
+func resolveInstallVersions(requested []string) ([]string, error) {
+	var versions []string
+	seen := make(map[string]bool)
+	latest := ""
+	for _, version := range requested {
+		if strings.EqualFold(version, "latest") {
+			if latest == "" {
+				fmt.Println("Fetching latest version...")
+				resolved, err := utils.GetLatestVersionWithFallback()
+				if err != nil {
+					return nil, fmt.Errorf("failed to get latest version: %w", err)
+				}
+				latest = resolved
+				fmt.Printf("Latest version: %s\n", latest)
+			}
+			version = latest
+		} else if err := utils.ValidateVersionName(version); err != nil {
+			// Versions become directory and lock names
+			return nil, err
+		}
+		if !seen[version] {
+			seen[version] = true
+			versions = append(versions, version)
+		}
+	}
+	return versions, nil
+}
+
+// versionInstalled reports whether versionsDir holds the binary of version
+func versionInstalled(versionsDir, version string) bool {
+	_, err := os.Stat(filepath.Join(versionsDir, version, utils.BinaryName))
+	return err == nil
+}
+
+// installVersions installs the versions that are not in versionsDir yet, running at most maxParallel
+// installs at a time. Results are in the order of versions.
+func installVersions(versionsDir string, versions []string, maxParallel int, install func(version string) error) []InstallResult {
+	results := make([]InstallResult, len(versions))
+	var g errgroup.Group
+	g.SetLimit(maxParallel)
+
+	for i, version := range versions {
+		if versionInstalled(versionsDir, version) {
+			results[i] = InstallResult{Version: version, Status: InstallSkipped}
+			continue
+		}
+		g.Go(func() error {
+			start := time.Now()
+			err := install(version)
+			result := InstallResult{Version: version, Status: InstallInstalled, Duration: time.Since(start), Err: err}
+			if err != nil {
+				result.Status = InstallFailed
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return results
+}
+
+// countInstallFailures returns how many versions failed to install
+func countInstallFailures(results []InstallResult) int {
+	failed := 0
+	for _, result := range results {
+		if result.Status == InstallFailed {
+			failed++
+		}
+	}
+	return failed
+}
+
+// displayInstallResults prints one row per requested version
+func displayInstallResults(results []InstallResult) {
+	fmt.Println("\n📋 Install summary")
+	// Auto formatting would mangle version numbers
+	table := tablewriter.NewTable(os.Stdout, tablewriter.WithHeaderAutoFormat(tw.Off))
+	table.Header("VERSION", "STATUS", "TIME", "DETAILS")
+	for _, result := range results {
+		status, elapsed, details := "⏭️  "+result.Status, "-", ""
+		switch result.Status {
+		case InstallInstalled:
+			status = "✅ " + result.Status
+		case InstallFailed:
+			status = "❌ " + result.Status
+			details = result.Err.Error()
+		}
+		if result.Duration > 0 {
+			elapsed = result.Duration.Round(100 * time.Millisecond).String()
+		}
+		_ = table.Append(result.Version, status, elapsed, details)
+	}
+	_ = table.Render()
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+)
+
+func TestReadVersionsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "versions.txt")
+	content := "# build image versions\n2.55.0\n\n  2.60.0  # pinned by team A\nlatest\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	versions, err := readVersionsFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"2.55.0", "2.60.0", "latest"}; !reflect.DeepEqual(versions, expected) {
+		t.Errorf("expected %v, got %v", expected, versions)
+	}
+
+	if _, err := readVersionsFile(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Errorf("expected an error for a missing file")
+	}
+
+	if err := os.WriteFile(path, []byte("2.55.0\n# escapes the store\n../../x\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readVersionsFile(path); err == nil || !strings.Contains(err.Error(), "versions.txt:3: invalid version \"../../x\"") {
+		t.Errorf("expected the bad line to be reported, got %v", err)
+	}
+}
+
+func TestInstallVersions(t *testing.T) {
+	oldVersions := utils.JFCMVersions
+	utils.JFCMVersions = t.TempDir()
+	defer func() { utils.JFCMVersions = oldVersions }()
+	installFakeVersion(t, "2.50.0", "jf version 2.50.0")
+
+	var mu sync.Mutex
+	var installed []string
+	results := installVersions(utils.JFCMVersions, []string{"2.50.0", "2.51.0", "9.9.9", "2.52.0"}, 2, func(version string) error {
+		if version == "9.9.9" {
+			return errors.New("version 9.9.9 not found")
+		}
+		mu.Lock()
+		installed = append(installed, version)
+		mu.Unlock()
+		return nil
+	})
+
+	statuses := make([]string, len(results))
+	for i, result := range results {
+		statuses[i] = result.Version + " " + result.Status
+	}
+	expected := []string{"2.50.0 " + InstallSkipped, "2.51.0 " + InstallInstalled, "9.9.9 " + InstallFailed, "2.52.0 " + InstallInstalled}
+	if !reflect.DeepEqual(statuses, expected) {
+		t.Errorf("expected %v, got %v", expected, statuses)
+	}
+	if len(installed) != 2 {
+		t.Errorf("expected 2 installs, got %v", installed)
+	}
+	if failed := countInstallFailures(results); failed != 1 {
+		t.Errorf("expected 1 failure, got %d", failed)
+	}
+}
+
+func TestResolveInstallVersionsDropsDuplicates(t *testing.T) {
+	versions, err := resolveInstallVersions([]string{"2.55.0", "2.60.0", "2.55.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"2.55.0", "2.60.0"}; !reflect.DeepEqual(versions, expected) {
+		t.Errorf("expected %v, got %v", expected, versions)
+	}
+}
+
+func TestResolveInstallVersionsRejectsPaths(t *testing.T) {
+	for _, version := range []string{"../../x", "2.55.0/..", `..\x`, "nightly"} {
+		if _, err := resolveInstallVersions([]string{"2.55.0", version}); err == nil {
+			t.Errorf("%s: expected an invalid version error", version)
+		}
+	}
+}
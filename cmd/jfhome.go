@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+)
+
+// Isolation modes for the JFrog CLI home directory used by compare and benchmark
+const (
+	IsolateHomeNone       = ""
+	IsolateHomePerVersion = "per-version"
+	IsolateHomePerRun     = "per-run"
+)
+
+// JFHomeEnvVar is the environment variable jf reads its home directory from
+const JFHomeEnvVar = "JFROG_CLI_HOME_DIR"
+
+// JFHomeSnapshot is a temporary copy of the JFrog CLI home directory that a jf child process
+// can use and migrate freely without touching the user's real configuration
+type JFHomeSnapshot struct {
+	Dir string
+}
+
+// validateIsolateHome checks the value of the --isolate-home flag
+func validateIsolateHome(mode string) error {
+	switch mode {
+	case IsolateHomeNone, IsolateHomePerVersion, IsolateHomePerRun:
+		return nil
+	}
+	return fmt.Errorf("unsupported isolate-home mode '%s' (supported: per-version, per-run)", mode)
+}
+
+// jfHomeSource returns the JFrog CLI home directory of the current user
+func jfHomeSource() string {
+	if dir := os.Getenv(JFHomeEnvVar); dir != "" {
+		return dir
+	}
+	return filepath.Join(utils.HomeDir, ".jfrog")
+}
+
+// NewJFHomeSnapshot copies the user's JFrog CLI home into a new temporary directory.
+// A missing home results in an empty snapshot, which is what jf would see on a fresh machine.
+func NewJFHomeSnapshot() (*JFHomeSnapshot, error) {
+	dir, err := os.MkdirTemp("", "jfcm-jfhome-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary jf home: %w", err)
+	}
+
+	source := jfHomeSource()
+	if _, err := os.Stat(source); err == nil {
+		if err := utils.CopyDir(source, dir); err != nil {
+			os.RemoveAll(dir)
+			return nil, fmt.Errorf("failed to snapshot jf home %s: %w", source, err)
+		}
+	}
+
+	return &JFHomeSnapshot{Dir: dir}, nil
+}
+
+// Env returns the environment entries that point a jf child process at the snapshot
+func (s *JFHomeSnapshot) Env() []string {
+	if s == nil {
+		return nil
+	}
+	return []string{JFHomeEnvVar + "=" + s.Dir}
+}
+
+// Cleanup removes the snapshot directory
+func (s *JFHomeSnapshot) Cleanup() {
+	if s == nil {
+		return
+	}
+	if err := os.RemoveAll(s.Dir); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: failed to remove temporary jf home %s: %v\n", s.Dir, err)
+	}
+}
+
+// newSnapshotIfEnabled creates a snapshot when isolation is enabled and returns nil otherwise
+func newSnapshotIfEnabled(mode string) (*JFHomeSnapshot, error) {
+	if mode == IsolateHomeNone {
+		return nil, nil
+	}
+	return NewJFHomeSnapshot()
+}
+
+// newSnapshotsIfEnabled creates one snapshot per target when isolation is enabled. The returned
+// slice always has count entries (nil when disabled) and cleanup removes all created snapshots.
+func newSnapshotsIfEnabled(mode string, count int) ([]*JFHomeSnapshot, func(), error) {
+	snapshots := make([]*JFHomeSnapshot, count)
+	cleanup := func() {
+		for _, snapshot := range snapshots {
+			snapshot.Cleanup()
+		}
+	}
+
+	for i := range snapshots {
+		snapshot, err := newSnapshotIfEnabled(mode)
+		if err != nil {
+			cleanup()
+			return nil, func() {}, err
+		}
+		snapshots[i] = snapshot
+	}
+
+	return snapshots, cleanup, nil
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestJFHomeSnapshot(t *testing.T) {
+	source := t.TempDir()
+	if err := os.WriteFile(filepath.Join(source, "jfrog-cli.conf.v6"), []byte(`{"version": "6"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(JFHomeEnvVar, source)
+
+	snapshot, err := NewJFHomeSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer snapshot.Cleanup()
+
+	if snapshot.Dir == source {
+		t.Fatal("expected the snapshot to be a copy")
+	}
+	if env := snapshot.Env(); len(env) != 1 || env[0] != JFHomeEnvVar+"="+snapshot.Dir {
+		t.Errorf("expected %s to point at %s, got %v", JFHomeEnvVar, snapshot.Dir, env)
+	}
+	if got, _ := os.ReadFile(filepath.Join(snapshot.Dir, "jfrog-cli.conf.v6")); string(got) != `{"version": "6"}` {
+		t.Errorf("expected the config in the snapshot, got %q", got)
+	}
+
+	// jf migrating the snapshot leaves the real home alone
+	if err := os.WriteFile(filepath.Join(snapshot.Dir, "jfrog-cli.conf.v6"), []byte("migrated"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := os.ReadFile(filepath.Join(source, "jfrog-cli.conf.v6")); string(got) != `{"version": "6"}` {
+		t.Errorf("expected the source home to be unchanged, got %q", got)
+	}
+
+	snapshot.Cleanup()
+	if _, err := os.Stat(snapshot.Dir); !os.IsNotExist(err) {
+		t.Errorf("expected the snapshot to be removed")
+	}
+
+	// A missing home gives an empty snapshot
+	t.Setenv(JFHomeEnvVar, filepath.Join(source, "missing"))
+	empty, err := NewJFHomeSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer empty.Cleanup()
+	if entries, err := os.ReadDir(empty.Dir); err != nil || len(entries) != 0 {
+		t.Errorf("expected an empty snapshot, got %v: %v", entries, err)
+	}
+
+	var disabled *JFHomeSnapshot
+	if env := disabled.Env(); env != nil {
+		t.Errorf("expected no environment without isolation, got %v", env)
+	}
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+)
+
+// jfConflictPrefix starts the component name of a non-jfcm jf found on PATH
+const jfConflictPrefix = "jf at "
+
+// jfInstallationStatuses reports the jf that wins resolution and every other jf on PATH.
+// Installations resolved before the jfcm shim fail; those shadowed by it are warnings.
+func jfInstallationStatuses(installations []utils.JfInstallation, verbose bool) []HealthStatus {
+	var results []HealthStatus
+
+	status := HealthStatus{Component: "Active jf Binary"}
+	if len(installations) == 0 {
+		status.Status = "fail"
+		status.Message = "jf binary not found in PATH"
+		return append(results, status)
+	}
+
+	active := installations[0]
+	if active.IsJFCM() {
+		status.Status = "pass"
+		status.Message = "jfcm-managed jf is active"
+	} else {
+		status.Status = "fail"
+		status.Message = fmt.Sprintf("%s jf is active (not jfcm-managed)", active.Origin)
+		status.Fixable = true
+	}
+	if verbose || !active.IsJFCM() {
+		status.Details = active.Describe()
+	}
+	results = append(results, status)
+
+	shimSeen := false
+	for _, installation := range installations {
+		if installation.IsJFCM() {
+			shimSeen = true
+			continue
+		}
+
+		status := HealthStatus{Component: jfConflictPrefix + installation.Path}
+		if shimSeen {
+			status.Status = "warn"
+			status.Message = fmt.Sprintf("%s jf %s is shadowed by jfcm", installation.Origin, versionOrUnknown(installation.Version))
+		} else {
+			status.Status = "fail"
+			status.Message = fmt.Sprintf("%s jf %s takes precedence over jfcm", installation.Origin, versionOrUnknown(installation.Version))
+			status.Fixable = true
+		}
+		if verbose && installation.Resolved != installation.Path {
+			status.Details = "Resolves to " + installation.Resolved
+		}
+		results = append(results, status)
+	}
+
+	return results
+}
+
+// versionOrUnknown returns version, or a placeholder when it could not be read
+func versionOrUnknown(version string) string {
+	if version == "" {
+		return "(unknown version)"
+	}
+	return version
+}
+
+// adoptConflictingJf copies the jf named by a conflict status into jfcm when its version is not installed
+func adoptConflictingJf(component string, out io.Writer) error {
+	path := strings.TrimPrefix(component, jfConflictPrefix)
+	for _, installation := range utils.FindJfInstallations(os.Getenv("PATH"), true) {
+		if installation.Path != path {
+			continue
+		}
+		version, err := utils.AdoptJfBinary(installation)
+		if err != nil {
+			return fmt.Errorf("failed to adopt %s: %w", path, err)
+		}
+		fmt.Fprintf(out, "📥 %s is available as jfcm version %s\n", path, version)
+		return nil
+	}
+	return fmt.Errorf("%s is no longer on PATH", path)
+}
+
+// printJfInstallations lists the jf executables on PATH, marking the one the shell runs
+func printJfInstallations(installations []utils.JfInstallation) {
+	if len(installations) == 0 {
+		fmt.Println("⚠️  No jf executable found on PATH")
+		return
+	}
+
+	fmt.Println("🔎 jf executables on PATH (first one wins):")
+	for _, installation := range installations {
+		marker := "  "
+		if installation.Active {
+			marker = "→ "
+		}
+		fmt.Printf("  %s%-9s %-18s %s\n", marker, installation.Origin, versionOrUnknown(installation.Version), installation.Path)
+	}
+
+	if !installations[0].IsJFCM() {
+		fmt.Printf("💡 Run 'jfcm health-check --fix' to adopt %s into jfcm and put the jfcm shim first in PATH\n",
+			installations[0].Path)
+	}
+}
@@ -17,7 +17,7 @@ var Link = &cli.Command{
 		&cli.StringFlag{Name: "from", Usage: "Path to the local jf binary", Required: true},
 		&cli.StringFlag{Name: "name", Usage: "Version name to assign", Required: true},
 	},
-	Action: func(c *cli.Context) error {
+	Action: withStateLock(func(c *cli.Context) error {
 		from := c.String("from")
 		name := c.String("name")
 
@@ -54,8 +54,21 @@ var Link = &cli.Command{
 		if err := os.Chmod(targetBin, 0755); err != nil {
 			return err
 		}
+		if err := dst.Close(); err != nil {
+			return err
+		}
+		source, err := filepath.Abs(from)
+		if err != nil {
+			source = from
+		}
+		if _, err := utils.WriteVersionMeta(name, utils.VersionMeta{
+			SourceURL: "file://" + filepath.ToSlash(source),
+			Installer: utils.InstallerLink,
+		}); err != nil {
+			return err
+		}
 
 		fmt.Printf("✅ Linked %s as jfcm version %s\n", from, name)
 		return nil
-	},
+	}),
 }
@@ -132,9 +132,13 @@ func displayEnhancedList(noColor bool) error {
 		Size       string
 		ModTime    time.Time
 		BinaryPath string
+		Installer  string
+		LastUsed   time.Time
 	}
 
 	var versions []VersionInfo
+	// jf calls through the shim are recorded in the history and the last-used marker, not in meta.json
+	usedInHistory := historyLastUsed()
 
 	for _, entry := range entries {
 		if entry.IsDir() {
@@ -145,18 +149,24 @@ func displayEnhancedList(noColor bool) error {
 				Name:       version,
 				IsCurrent:  version == current,
 				BinaryPath: filepath.Join(versionPath, utils.BinaryName),
+				Size:       "N/A",
+				Installer:  utils.InstallerUnknown,
 			}
 
-			// Get modification time and size
-			if stat, err := entry.Info(); err == nil {
+			// Prefer the recorded metadata; fall back to the directory for broken installs
+			if meta, _, err := utils.EnsureVersionMeta(version); err == nil {
+				info.ModTime = meta.InstalledAt.Local()
+				info.Size = formatFileSize(meta.Size)
+				info.Installer = meta.Installer
+				info.LastUsed = meta.LastUsedAt.Local()
+			} else if stat, err := entry.Info(); err == nil {
 				info.ModTime = stat.ModTime()
 			}
-
-			// Get binary size if exists
-			if binStat, err := os.Stat(info.BinaryPath); err == nil {
-				info.Size = formatFileSize(binStat.Size())
-			} else {
-				info.Size = "N/A"
+			if used := usedInHistory[version]; used.After(info.LastUsed) {
+				info.LastUsed = used.Local()
+			}
+			if used := utils.MarkerLastUsed(version); used.After(info.LastUsed) {
+				info.LastUsed = used.Local()
 			}
 
 			versions = append(versions, info)
@@ -190,9 +200,15 @@ func displayEnhancedList(noColor bool) error {
 			header += currentBadgeStyle.Render("CURRENT")
 		}
 
-		metadata := fmt.Sprintf("📅 %s\n📦 %s",
+		lastUsed := "never"
+		if !version.LastUsed.IsZero() {
+			lastUsed = version.LastUsed.Format("Jan 02, 2006")
+		}
+		metadata := fmt.Sprintf("📅 %s\n📦 %s\n🔗 %s\n🕒 %s",
 			metaStyle.Render(version.ModTime.Format("Jan 02, 2006")),
-			metaStyle.Render(version.Size))
+			metaStyle.Render(version.Size),
+			metaStyle.Render(version.Installer),
+			metaStyle.Render("used "+lastUsed))
 
 		cardContent := header + "\n\n" + metadata
 		card := cardStyle.Width(25).Render(cardContent)
@@ -0,0 +1,299 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+)
+
+const (
+	// networkProbeTimeout bounds each endpoint probe
+	networkProbeTimeout = 5 * time.Second
+
+	// certExpiryWarning is how close to expiry a certificate is reported as a warning
+	certExpiryWarning = 30 * 24 * time.Hour
+)
+
+// ProbeStage is the outcome of one connection stage. Reached is false when an earlier stage failed
+// or the stage does not apply (no DNS lookup for IP addresses, no TLS for plain HTTP).
+type ProbeStage struct {
+	Reached  bool
+	Err      error
+	Duration time.Duration
+	Detail   string
+}
+
+// EndpointProbe is the stage-by-stage result of connecting to an endpoint
+type EndpointProbe struct {
+	Name       string
+	URL        string
+	Proxy      string
+	DNS        ProbeStage
+	TCP        ProbeStage
+	TLS        ProbeStage
+	HTTP       ProbeStage
+	StatusCode int
+	CertIssuer string
+	CertExpiry time.Time
+}
+
+// networkProber probes endpoints with net/http, honouring the proxy configuration
+type networkProber struct {
+	Proxy     func(*http.Request) (*url.URL, error)
+	TLSConfig *tls.Config
+	Timeout   time.Duration
+}
+
+// newNetworkProber returns a prober using HTTP(S)_PROXY and NO_PROXY from the environment
+func newNetworkProber() *networkProber {
+	return &networkProber{Proxy: http.ProxyFromEnvironment, Timeout: networkProbeTimeout}
+}
+
+// Probe sends a GET request to rawURL and records DNS, TCP, TLS and HTTP separately. With a proxy,
+// DNS and TCP refer to the proxy while TLS is the handshake with the endpoint through the tunnel.
+func (p *networkProber) Probe(ctx context.Context, name, rawURL string) EndpointProbe {
+	probe := EndpointProbe{Name: name, URL: rawURL}
+
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		probe.HTTP = ProbeStage{Reached: true, Err: err}
+		return probe
+	}
+	req.Header.Set("User-Agent", "jfcm/1.0")
+
+	if p.Proxy != nil {
+		if proxyURL, err := p.Proxy(req); err != nil {
+			probe.HTTP = ProbeStage{Reached: true, Err: fmt.Errorf("invalid proxy configuration: %w", err)}
+			return probe
+		} else if proxyURL != nil {
+			probe.Proxy = proxyURL.Redacted()
+		}
+	}
+
+	var (
+		mu                               sync.Mutex
+		dnsStart, connectStart, tlsStart time.Time
+		dnsStage, tcpStage, tlsStage     ProbeStage
+		gotFirstByte                     time.Time
+		requestStart                     = time.Now()
+	)
+	trace := &httptrace.ClientTrace{
+		DNSStart: func(info httptrace.DNSStartInfo) {
+			mu.Lock()
+			defer mu.Unlock()
+			dnsStart = time.Now()
+			dnsStage = ProbeStage{Reached: true, Detail: info.Host}
+		},
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			mu.Lock()
+			defer mu.Unlock()
+			dnsStage.Duration = time.Since(dnsStart)
+			dnsStage.Err = info.Err
+			if info.Err == nil && len(info.Addrs) > 0 {
+				dnsStage.Detail = fmt.Sprintf("%s → %s", dnsStage.Detail, info.Addrs[0].String())
+			}
+		},
+		ConnectStart: func(network, addr string) {
+			mu.Lock()
+			defer mu.Unlock()
+			// Happy eyeballs may dial several addresses; report the first attempt
+			if connectStart.IsZero() {
+				connectStart = time.Now()
+				tcpStage = ProbeStage{Reached: true, Detail: addr}
+			}
+		},
+		ConnectDone: func(network, addr string, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if err == nil || tcpStage.Err == nil {
+				tcpStage.Duration = time.Since(connectStart)
+				tcpStage.Err = err
+				tcpStage.Detail = addr
+			}
+		},
+		TLSHandshakeStart: func() {
+			mu.Lock()
+			defer mu.Unlock()
+			tlsStart = time.Now()
+			tlsStage = ProbeStage{Reached: true}
+		},
+		TLSHandshakeDone: func(state tls.ConnectionState, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			tlsStage.Duration = time.Since(tlsStart)
+			tlsStage.Err = err
+			// Verification rejects expired certificates, so their expiry is only in the error
+			var invalid x509.CertificateInvalidError
+			if errors.As(err, &invalid) && invalid.Reason == x509.Expired {
+				probe.CertIssuer = certificateIssuer(invalid.Cert)
+				probe.CertExpiry = invalid.Cert.NotAfter
+			}
+			if err == nil {
+				tlsStage.Detail = tls.VersionName(state.Version)
+				if len(state.PeerCertificates) > 0 {
+					cert := state.PeerCertificates[0]
+					probe.CertIssuer = certificateIssuer(cert)
+					probe.CertExpiry = cert.NotAfter
+				}
+			}
+		},
+		GotFirstResponseByte: func() {
+			mu.Lock()
+			defer mu.Unlock()
+			gotFirstByte = time.Now()
+		},
+	}
+
+	transport := &http.Transport{
+		Proxy:               p.Proxy,
+		TLSClientConfig:     p.TLSConfig,
+		DisableKeepAlives:   true,
+		TLSHandshakeTimeout: p.Timeout,
+	}
+	client := &http.Client{Transport: transport, Timeout: p.Timeout}
+
+	ctx, cancel := context.WithTimeout(ctx, p.Timeout)
+	defer cancel()
+	resp, err := client.Do(req.WithContext(httptrace.WithClientTrace(ctx, trace)))
+
+	mu.Lock()
+	defer mu.Unlock()
+	probe.DNS, probe.TCP, probe.TLS = dnsStage, tcpStage, tlsStage
+
+	if err != nil {
+		// Attribute the error to the stage that failed; anything else is an HTTP level failure
+		if probe.DNS.Err == nil && probe.TCP.Err == nil && probe.TLS.Err == nil {
+			probe.HTTP = ProbeStage{Reached: true, Err: err, Duration: time.Since(requestStart)}
+		}
+		return probe
+	}
+	defer resp.Body.Close()
+
+	probe.StatusCode = resp.StatusCode
+	probe.HTTP = ProbeStage{Reached: true, Detail: resp.Status}
+	if !gotFirstByte.IsZero() {
+		probe.HTTP.Duration = gotFirstByte.Sub(requestStart)
+	}
+	if resp.StatusCode >= 400 {
+		probe.HTTP.Err = fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return probe
+}
+
+// certificateIssuer returns the common name of the issuer of cert, or its organization
+func certificateIssuer(cert *x509.Certificate) string {
+	if cert.Issuer.CommonName == "" && len(cert.Issuer.Organization) > 0 {
+		return cert.Issuer.Organization[0]
+	}
+	return cert.Issuer.CommonName
+}
+
+// probeStatuses converts a probe into one health status per reached stage. Network problems are
+// warnings since jfcm works offline with installed versions.
+func probeStatuses(probe EndpointProbe, verbose bool, now time.Time) []HealthStatus {
+	var results []HealthStatus
+	stage := func(suffix string, s ProbeStage, ok string) {
+		status := HealthStatus{Component: probe.Name + " " + suffix}
+		if s.Err != nil {
+			status.Status = "warn"
+			status.Message = fmt.Sprintf("%s failed: %v", suffix, s.Err)
+		} else {
+			status.Status = "pass"
+			status.Message = ok
+		}
+		if verbose {
+			status.Details = strings.TrimSpace(fmt.Sprintf("%s %s", s.Detail, formatProbeDuration(s.Duration)))
+		}
+		results = append(results, status)
+	}
+
+	via := ""
+	if probe.Proxy != "" {
+		via = " (proxy " + probe.Proxy + ")"
+	}
+
+	if probe.DNS.Reached {
+		stage("DNS", probe.DNS, "Resolved "+probe.DNS.Detail+via)
+	}
+	if probe.TCP.Reached {
+		stage("TCP", probe.TCP, "Connected to "+probe.TCP.Detail+via)
+	}
+	if probe.TLS.Reached {
+		ok := "Handshake completed"
+		if probe.TLS.Detail != "" {
+			ok += " (" + probe.TLS.Detail + ")"
+		}
+		stage("TLS", probe.TLS, ok)
+
+		if !probe.CertExpiry.IsZero() {
+			status := HealthStatus{Component: probe.Name + " Certificate"}
+			remaining := probe.CertExpiry.Sub(now)
+			expiry := probe.CertExpiry.Format("2006-01-02")
+			switch {
+			case remaining <= 0:
+				status.Status = "fail"
+				status.Message = fmt.Sprintf("Certificate issued by %s expired on %s", probe.CertIssuer, expiry)
+			case remaining < certExpiryWarning:
+				status.Status = "warn"
+				status.Message = fmt.Sprintf("Certificate issued by %s expires soon (%s)", probe.CertIssuer, expiry)
+			default:
+				status.Status = "pass"
+				status.Message = fmt.Sprintf("Certificate issued by %s, valid until %s", probe.CertIssuer, expiry)
+			}
+			results = append(results, status)
+		}
+	}
+	if probe.HTTP.Reached {
+		stage("HTTP", probe.HTTP, "HTTP "+probe.HTTP.Detail)
+	}
+	return results
+}
+
+// probeEndpoints probes every endpoint and reports the proxy each one goes through
+func probeEndpoints(prober *networkProber, endpoints []networkEndpoint, verbose bool) []HealthStatus {
+	var results []HealthStatus
+	for _, endpoint := range endpoints {
+		probe := prober.Probe(context.Background(), endpoint.Name, endpoint.URL)
+		if probe.Proxy != "" {
+			results = append(results, HealthStatus{
+				Component: endpoint.Name + " Proxy",
+				Status:    "pass",
+				Message:   "Using proxy " + probe.Proxy,
+			})
+		}
+		results = append(results, probeStatuses(probe, verbose, time.Now())...)
+	}
+	return results
+}
+
+// formatProbeDuration formats a stage duration, or "" when unknown
+func formatProbeDuration(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return "(" + formatDuration(d) + ")"
+}
+
+// networkEndpoint is a remote service jfcm depends on
+type networkEndpoint struct {
+	Name string
+	URL  string
+}
+
+// networkEndpoints returns the configured GitHub API and binary mirror endpoints
+func networkEndpoints() []networkEndpoint {
+	return []networkEndpoint{
+		{Name: "GitHub API", URL: utils.GitHubAPIBaseURL()},
+		{Name: "JFrog Releases", URL: utils.MirrorBaseURL() + "/"},
+	}
+}
@@ -0,0 +1,197 @@
+package cmd
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// statusByComponent indexes statuses by the component suffix after the endpoint name
+func statusByComponent(statuses []HealthStatus, name string) map[string]HealthStatus {
+	indexed := make(map[string]HealthStatus)
+	for _, status := range statuses {
+		indexed[strings.TrimPrefix(status.Component, name+" ")] = status
+	}
+	return indexed
+}
+
+func TestProbeTLSEndpoint(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	// The untrusted probe below makes the server log a handshake error
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	prober := &networkProber{TLSConfig: &tls.Config{RootCAs: pool}, Timeout: 5 * time.Second}
+
+	probe := prober.Probe(context.Background(), "Mirror", server.URL)
+	if probe.DNS.Reached {
+		t.Errorf("no DNS lookup is expected for an IP address")
+	}
+	if !probe.TCP.Reached || probe.TCP.Err != nil || !probe.TLS.Reached || probe.TLS.Err != nil {
+		t.Fatalf("unexpected TCP/TLS stages: %+v %+v", probe.TCP, probe.TLS)
+	}
+	if probe.StatusCode != http.StatusOK || probe.HTTP.Err != nil {
+		t.Errorf("unexpected HTTP stage: %d %+v", probe.StatusCode, probe.HTTP)
+	}
+	if probe.CertIssuer == "" || probe.CertExpiry.Before(time.Now()) {
+		t.Errorf("expected certificate details, got issuer %q expiry %v", probe.CertIssuer, probe.CertExpiry)
+	}
+
+	statuses := statusByComponent(probeStatuses(probe, false, time.Now()), "Mirror")
+	for _, stage := range []string{"TCP", "TLS", "Certificate", "HTTP"} {
+		if statuses[stage].Status != "pass" {
+			t.Errorf("expected %s to pass, got %+v", stage, statuses[stage])
+		}
+	}
+
+	// Without the test CA the handshake fails and is reported as such
+	untrusted := (&networkProber{Timeout: 5 * time.Second}).Probe(context.Background(), "Mirror", server.URL)
+	if untrusted.TLS.Err == nil || untrusted.HTTP.Reached {
+		t.Errorf("expected a TLS failure, got TLS %+v HTTP %+v", untrusted.TLS, untrusted.HTTP)
+	}
+}
+
+func TestProbeExpiredCertificate(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Expired CA"},
+		NotBefore:             time.Now().AddDate(-1, 0, 0),
+		NotAfter:              time.Now().AddDate(0, 0, -2),
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+	server.StartTLS()
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	probe := (&networkProber{TLSConfig: &tls.Config{RootCAs: pool}, Timeout: 5 * time.Second}).Probe(context.Background(), "Mirror", server.URL)
+	if probe.TLS.Err == nil {
+		t.Fatal("expected the handshake to fail")
+	}
+
+	statuses := statusByComponent(probeStatuses(probe, false, time.Now()), "Mirror")
+	expected := "Certificate issued by Expired CA expired on " + cert.NotAfter.Format("2006-01-02")
+	if status := statuses["Certificate"]; status.Status != "fail" || status.Message != expected {
+		t.Errorf("expected %q, got %+v", expected, status)
+	}
+}
+
+func TestProbeHTTPStatusAndDNS(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(server.URL, "http://"))
+	probe := (&networkProber{Timeout: 5 * time.Second}).Probe(context.Background(), "API", "http://localhost:"+port)
+
+	if !probe.DNS.Reached || probe.DNS.Err != nil {
+		t.Errorf("expected a successful DNS lookup of localhost, got %+v", probe.DNS)
+	}
+	if probe.TLS.Reached {
+		t.Errorf("no TLS handshake is expected for plain HTTP")
+	}
+	statuses := statusByComponent(probeStatuses(probe, false, time.Now()), "API")
+	if statuses["HTTP"].Status != "warn" || !strings.Contains(statuses["HTTP"].Message, "503") {
+		t.Errorf("expected a 503 warning, got %+v", statuses["HTTP"])
+	}
+}
+
+func TestProbeThroughProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	prober := &networkProber{Proxy: http.ProxyURL(proxyURL), Timeout: 5 * time.Second}
+	probe := prober.Probe(context.Background(), "Releases", "http://releases.jfcm.invalid/jfrog-cli/")
+
+	if probe.Proxy != proxy.URL {
+		t.Errorf("expected proxy %s, got %q", proxy.URL, probe.Proxy)
+	}
+	if probe.StatusCode != http.StatusOK || proxied != "http://releases.jfcm.invalid/jfrog-cli/" {
+		t.Errorf("expected the request to go through the proxy, got %d for %q", probe.StatusCode, proxied)
+	}
+	if !strings.Contains(probe.TCP.Detail, strings.TrimPrefix(proxy.URL, "http://")) {
+		t.Errorf("expected TCP to connect to the proxy, got %q", probe.TCP.Detail)
+	}
+}
+
+func TestProbeConnectionRefused(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	probe := (&networkProber{Timeout: 5 * time.Second}).Probe(context.Background(), "API", "http://"+addr)
+	statuses := statusByComponent(probeStatuses(probe, false, time.Now()), "API")
+	if statuses["TCP"].Status != "warn" {
+		t.Errorf("expected a TCP warning, got %+v", statuses)
+	}
+	if _, ok := statuses["HTTP"]; ok {
+		t.Errorf("HTTP should not be reported when the connection fails")
+	}
+}
+
+func TestProbeStatusesCertificateExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	probe := EndpointProbe{Name: "API", TLS: ProbeStage{Reached: true}, CertIssuer: "Test CA"}
+
+	for expiry, expected := range map[time.Time]string{
+		now.Add(-time.Hour):          "fail",
+		now.Add(10 * 24 * time.Hour): "warn",
+		now.AddDate(1, 0, 0):         "pass",
+	} {
+		probe.CertExpiry = expiry
+		status := statusByComponent(probeStatuses(probe, false, now), "API")["Certificate"]
+		if status.Status != expected || !strings.Contains(status.Message, "Test CA") {
+			t.Errorf("expiry %v: expected %s, got %+v", expiry, expected, status)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// IgnoredPlaceholder replaces any text matched by a user supplied ignore pattern
+const IgnoredPlaceholder = "<ignored>"
+
+// JFHomePlaceholder replaces the path of an isolated jf home snapshot
+const JFHomePlaceholder = "<jf-home>"
+
+var (
+	// ansiEscapePattern matches ANSI color and cursor control sequences
+	ansiEscapePattern = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]`)
+
+	// jfHomeSnapshotPattern matches the temporary directories created by --isolate-home,
+	// which differ for every execution
+	jfHomeSnapshotPattern = regexp.MustCompile(`[^\s=:"']*jfcm-jfhome-\d+`)
+)
+
+// OutputNormalizer applies the comparison normalization rules to command output so that
+// cosmetic differences (colors, line endings, trailing spaces, volatile values) are not
+// reported as behaviour changes
+type OutputNormalizer struct {
+	IgnorePatterns []*regexp.Regexp
+}
+
+// NewOutputNormalizer compiles the given ignore patterns into a normalizer
+func NewOutputNormalizer(patterns []string) (*OutputNormalizer, error) {
+	normalizer := &OutputNormalizer{}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ignore pattern %q: %w", pattern, err)
+		}
+		normalizer.IgnorePatterns = append(normalizer.IgnorePatterns, re)
+	}
+	return normalizer, nil
+}
+
+// Normalize strips ANSI sequences, masks isolated jf home paths, unifies line endings,
+// trims trailing whitespace and masks every ignore pattern match
+func (n *OutputNormalizer) Normalize(output string) string {
+	output = ansiEscapePattern.ReplaceAllString(output, "")
+	output = jfHomeSnapshotPattern.ReplaceAllString(output, JFHomePlaceholder)
+	output = strings.ReplaceAll(output, "\r\n", "\n")
+
+	lines := strings.Split(output, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	output = strings.Join(lines, "\n")
+
+	if n != nil {
+		for _, re := range n.IgnorePatterns {
+			output = re.ReplaceAllString(output, IgnoredPlaceholder)
+		}
+	}
+
+	return strings.TrimSpace(output)
+}
@@ -0,0 +1,447 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/descriptions"
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+	"github.com/urfave/cli/v2"
+)
+
+var Prune = &cli.Command{
+	Name:        "prune",
+	Usage:       descriptions.Prune.Usage,
+	Description: descriptions.Prune.Format(),
+	Flags: []cli.Flag{
+		&cli.IntFlag{
+			Name:  "keep-latest",
+			Usage: "Keep the N newest versions",
+		},
+		&cli.StringFlag{
+			Name:  "unused-for",
+			Usage: "Remove versions not used for this long (e.g. 30d, 2w, 12h)",
+		},
+		&cli.BoolFlag{
+			Name:  "keep-aliased",
+			Usage: "Keep versions referenced by an alias",
+		},
+		&cli.StringSliceFlag{
+			Name:  "keep-pinned",
+			Usage: "Keep versions pinned by .jfrog-version files under these paths (repeatable or comma-separated)",
+		},
+		&cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "Show what would be removed without removing anything",
+		},
+	},
+	Action: withStateLock(func(c *cli.Context) error {
+		options, err := extractPruneOptions(c)
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		versions, err := installedVersionDirs()
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("❌ Failed to read %s: %v", utils.JFCMVersions, err), 1)
+		}
+		if len(versions) == 0 {
+			fmt.Println("No versions installed.")
+			return nil
+		}
+
+		state := PruneState{
+			LastUsed: versionLastUsed(versions, !options.DryRun),
+			Sizes:    make(map[string]int64),
+		}
+		state.Active, _ = utils.GetActiveVersion()
+		for _, version := range versions {
+			state.Sizes[version] = dirSize(filepath.Join(utils.JFCMVersions, version))
+		}
+		if options.KeepAliased {
+			if state.Aliased, err = aliasedVersions(); err != nil {
+				return cli.Exit(fmt.Sprintf("❌ Failed to read aliases: %v", err), 1)
+			}
+		}
+		if len(options.PinnedPaths) > 0 {
+			if state.Pinned, err = scanPinnedVersions(options.PinnedPaths, versions); err != nil {
+				return cli.Exit(fmt.Sprintf("❌ Failed to scan for %s files: %v", utils.ProjectFile, err), 1)
+			}
+		}
+
+		plan := planPrune(versions, state, options)
+		displayPrunePlan(plan, options.DryRun)
+		if options.DryRun {
+			return nil
+		}
+
+		var failed []string
+		for _, decision := range plan {
+			if !decision.Remove {
+				continue
+			}
+			if err := removePrunedVersion(decision.Version); err != nil {
+				failed = append(failed, decision.Version)
+				fmt.Fprintf(os.Stderr, "❌ Failed to remove %s: %v\n", decision.Version, err)
+			}
+		}
+		if len(failed) > 0 {
+			return cli.Exit(fmt.Sprintf("❌ Failed to remove: %s", strings.Join(failed, ", ")), 1)
+		}
+		return nil
+	}),
+}
+
+// PruneOptions holds the parsed prune flags
+type PruneOptions struct {
+	KeepLatest  int
+	UnusedFor   time.Duration
+	KeepAliased bool
+	PinnedPaths []string
+	DryRun      bool
+	Now         time.Time
+}
+
+func extractPruneOptions(c *cli.Context) (PruneOptions, error) {
+	options := PruneOptions{
+		KeepLatest:  c.Int("keep-latest"),
+		KeepAliased: c.Bool("keep-aliased"),
+		DryRun:      c.Bool("dry-run"),
+		Now:         time.Now(),
+	}
+	for _, value := range c.StringSlice("keep-pinned") {
+		for _, path := range strings.Split(value, ",") {
+			path = strings.TrimSpace(path)
+			if rest, ok := strings.CutPrefix(path, "~/"); ok {
+				if home, err := os.UserHomeDir(); err == nil {
+					path = filepath.Join(home, rest)
+				}
+			}
+			if path != "" {
+				options.PinnedPaths = append(options.PinnedPaths, path)
+			}
+		}
+	}
+
+	if options.KeepLatest < 0 {
+		return options, fmt.Errorf("❌ --keep-latest must not be negative")
+	}
+	if c.IsSet("unused-for") {
+		age, err := parseAge(c.String("unused-for"))
+		if err != nil {
+			return options, fmt.Errorf("❌ invalid --unused-for: %v", err)
+		}
+		options.UnusedFor = age
+	}
+	if !c.IsSet("keep-latest") && options.UnusedFor == 0 {
+		return options, fmt.Errorf("❌ specify --keep-latest and/or --unused-for to select versions to prune")
+	}
+	return options, nil
+}
+
+// ageUnits are the suffixes parseAge accepts on top of time.ParseDuration
+var ageUnits = []struct {
+	suffix string
+	name   string
+	unit   time.Duration
+}{
+	{"d", "days", 24 * time.Hour},
+	{"w", "weeks", 7 * 24 * time.Hour},
+}
+
+// parseAge parses a duration that also accepts days and weeks, such as "30d" or "2w"
+func parseAge(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	for _, u := range ageUnits {
+		if number, ok := strings.CutSuffix(value, u.suffix); ok {
+			n, err := strconv.Atoi(number)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("'%s' is not a positive number of %s", number, u.name)
+			}
+			return time.Duration(n) * u.unit, nil
+		}
+	}
+	age, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not a duration (use e.g. 30d, 2w or 12h)", value)
+	}
+	if age <= 0 {
+		return 0, fmt.Errorf("'%s' must be positive", value)
+	}
+	return age, nil
+}
+
+// PruneState is what prune knows about the installed versions
+type PruneState struct {
+	Active   string
+	LastUsed map[string]time.Time
+	Sizes    map[string]int64
+	Aliased  map[string][]string // version -> alias names
+	Pinned   map[string][]string // version -> .jfrog-version files
+}
+
+// PruneDecision says whether a version is removed and why
+type PruneDecision struct {
+	Version string
+	Size    int64
+	Remove  bool
+	Reason  string
+}
+
+// planPrune decides which versions to remove. A version is removed only when every selection
+// criterion given allows it; the active, aliased and pinned versions are always kept. Linked
+// versions have no place in the release order and are only removed by --unused-for.
+func planPrune(versions []string, state PruneState, options PruneOptions) []PruneDecision {
+	latest := make(map[string]bool)
+	if options.KeepLatest > 0 {
+		var semantic []string
+		for _, version := range versions {
+			if _, err := utils.ParseVersion(version); err == nil {
+				semantic = append(semantic, version)
+			}
+		}
+		sort.Slice(semantic, func(i, j int) bool {
+			return compareVersionNames(semantic[i], semantic[j]) > 0
+		})
+		for i := 0; i < len(semantic) && i < options.KeepLatest; i++ {
+			latest[semantic[i]] = true
+		}
+	}
+
+	var plan []PruneDecision
+	for _, version := range versions {
+		decision := PruneDecision{Version: version, Size: state.Sizes[version]}
+		lastUsed := state.LastUsed[version]
+		_, err := utils.ParseVersion(version)
+		linked := err != nil
+
+		switch {
+		case version == state.Active:
+			decision.Reason = "active version"
+		case len(state.Aliased[version]) > 0:
+			decision.Reason = "aliased as " + strings.Join(state.Aliased[version], ", ")
+		case len(state.Pinned[version]) > 0:
+			decision.Reason = "pinned by " + strings.Join(state.Pinned[version], ", ")
+		case latest[version]:
+			decision.Reason = fmt.Sprintf("among the %d newest", options.KeepLatest)
+		case linked && options.UnusedFor == 0:
+			decision.Reason = "linked version"
+		case options.UnusedFor > 0 && !lastUsed.IsZero() && options.Now.Sub(lastUsed) < options.UnusedFor:
+			decision.Reason = "used " + formatAge(options.Now.Sub(lastUsed)) + " ago"
+		default:
+			decision.Remove = true
+			var reasons []string
+			if !linked && (options.KeepLatest > 0 || options.UnusedFor == 0) {
+				reasons = append(reasons, fmt.Sprintf("not among the %d newest", options.KeepLatest))
+			}
+			if options.UnusedFor > 0 {
+				if lastUsed.IsZero() {
+					reasons = append(reasons, "never used")
+				} else {
+					reasons = append(reasons, "unused for "+formatAge(options.Now.Sub(lastUsed)))
+				}
+			}
+			decision.Reason = strings.Join(reasons, ", ")
+		}
+		plan = append(plan, decision)
+	}
+	return plan
+}
+
+// formatAge formats an age in whole days, or hours below a day
+func formatAge(age time.Duration) string {
+	if age < 24*time.Hour {
+		return fmt.Sprintf("%dh", int(age.Hours()))
+	}
+	return fmt.Sprintf("%dd", int(age.Hours()/24))
+}
+
+// versionLastUsed returns when each version was last used, from its metadata and the history.
+// Versions never used count from their install time so fresh installs are not pruned. Missing
+// metadata is only backfilled when backfill is set, so a dry run changes nothing.
+func versionLastUsed(versions []string, backfill bool) map[string]time.Time {
+	lastUsed := make(map[string]time.Time)
+	for _, version := range versions {
+		var meta *utils.VersionMeta
+		var err error
+		if backfill {
+			meta, _, err = utils.EnsureVersionMeta(version)
+		} else {
+			meta, err = readInstalledMeta(version)
+		}
+		if err == nil {
+			lastUsed[version] = meta.LastUsedAt
+			if meta.InstalledAt.After(lastUsed[version]) {
+				lastUsed[version] = meta.InstalledAt
+			}
+		}
+		if used := utils.MarkerLastUsed(version); used.After(lastUsed[version]) {
+			lastUsed[version] = used
+		}
+	}
+
+	for version, used := range historyLastUsed() {
+		if _, ok := lastUsed[version]; ok && used.After(lastUsed[version]) {
+			lastUsed[version] = used
+		}
+	}
+	return lastUsed
+}
+
+// readInstalledMeta returns the recorded metadata of a version without backfilling it. A version
+// without metadata gets the modification time of its binary as install time.
+func readInstalledMeta(version string) (*utils.VersionMeta, error) {
+	meta, err := utils.LoadVersionMeta(version)
+	if err == nil || !os.IsNotExist(err) {
+		return meta, err
+	}
+	info, err := os.Stat(filepath.Join(utils.JFCMVersions, version, utils.BinaryName))
+	if err != nil {
+		return nil, err
+	}
+	return &utils.VersionMeta{InstalledAt: info.ModTime().UTC()}, nil
+}
+
+// removePrunedVersion removes a version directory while holding the install lock of the version,
+// so it cannot be removed while an install is downloading into it
+func removePrunedVersion(version string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), utils.StateLockTimeout)
+	defer cancel()
+	versionLock, _, err := utils.LockVersion(ctx, utils.HostPlatform(), version)
+	if err != nil {
+		return err
+	}
+	defer versionLock.Release()
+	return os.RemoveAll(filepath.Join(utils.JFCMVersions, version))
+}
+
+// historyLastUsed returns the time of the latest history entry of each version
+func historyLastUsed() map[string]time.Time {
+	lastUsed := make(map[string]time.Time)
+	entries, _ := loadHistory(filepath.Join(utils.JFCMRoot, "history.json"))
+	for _, entry := range entries {
+		if entry.Timestamp.After(lastUsed[entry.Version]) {
+			lastUsed[entry.Version] = entry.Timestamp
+		}
+	}
+	return lastUsed
+}
+
+// aliasedVersions maps each version to the aliases pointing at it
+func aliasedVersions() (map[string][]string, error) {
+	aliased := make(map[string][]string)
+	entries, err := os.ReadDir(utils.JFCMAliases)
+	if os.IsNotExist(err) {
+		return aliased, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		// Hidden files are in-progress atomic writes
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		data, err := utils.GetAliasData(entry.Name())
+		if err != nil {
+			continue
+		}
+		version := strings.TrimSpace(data.Version)
+		aliased[version] = append(aliased[version], entry.Name())
+	}
+	return aliased, nil
+}
+
+// scanPinnedVersions walks paths for .jfrog-version files and maps the installed version each one
+// selects to the files pinning it. Constraints select the highest installed match, as `use` does.
+func scanPinnedVersions(paths []string, installed []string) (map[string][]string, error) {
+	pinned := make(map[string][]string)
+	for _, root := range paths {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				// Unreadable directories below the root are skipped
+				if path != root && d != nil && d.IsDir() {
+					return filepath.SkipDir
+				}
+				return err
+			}
+			if d.IsDir() {
+				if path != root && (d.Name() == ".git" || d.Name() == "node_modules") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.Name() != utils.ProjectFile {
+				return nil
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil
+			}
+			version := strings.TrimSpace(string(data))
+			if utils.IsVersionConstraint(version) {
+				if version, err = utils.FindMatchingVersion(version, installed); err != nil {
+					return nil
+				}
+			} else if resolved, err := utils.ResolveVersionOrAlias(version); err == nil {
+				version = resolved
+			}
+			pinned[version] = append(pinned[version], path)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pinned, nil
+}
+
+// dirSize returns the total size of the regular files under dir
+func dirSize(dir string) int64 {
+	var size int64
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && d.Type().IsRegular() {
+			if info, err := d.Info(); err == nil {
+				size += info.Size()
+			}
+		}
+		return nil
+	})
+	return size
+}
+
+func displayPrunePlan(plan []PruneDecision, dryRun bool) {
+	removeVerb := "Removing"
+	if dryRun {
+		removeVerb = "Would remove"
+	}
+
+	var reclaimed int64
+	removed := 0
+	for _, decision := range plan {
+		if decision.Remove {
+			fmt.Printf("🗑️  %s %s (%s): %s\n", removeVerb, decision.Version, formatFileSize(decision.Size), decision.Reason)
+			reclaimed += decision.Size
+			removed++
+		} else {
+			fmt.Printf("📌 Keeping %s: %s\n", decision.Version, decision.Reason)
+		}
+	}
+
+	switch {
+	case removed == 0:
+		fmt.Println("✅ Nothing to prune")
+	case dryRun:
+		fmt.Printf("💾 Would reclaim %s (%d bytes) from %d versions\n", formatFileSize(reclaimed), reclaimed, removed)
+	default:
+		fmt.Printf("💾 Reclaimed %s (%d bytes) from %d versions\n", formatFileSize(reclaimed), reclaimed, removed)
+	}
+}
@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+)
+
+// prunedVersions returns the versions a plan removes
+func prunedVersions(plan []PruneDecision) []string {
+	var removed []string
+	for _, decision := range plan {
+		if decision.Remove {
+			removed = append(removed, decision.Version)
+		}
+	}
+	return removed
+}
+
+func TestPlanPrune(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	versions := []string{"2.50.0", "2.51.0", "2.52.0", "2.53.0", "2.54.0", "local-dev"}
+	state := PruneState{
+		Active: "2.50.0",
+		LastUsed: map[string]time.Time{
+			"2.50.0":    now.AddDate(0, 0, -90),
+			"2.51.0":    now.AddDate(0, 0, -60),
+			"2.52.0":    now.AddDate(0, 0, -5),
+			"2.53.0":    now.AddDate(0, 0, -40),
+			"2.54.0":    now.AddDate(0, 0, -1),
+			"local-dev": now.AddDate(0, 0, -45),
+		},
+		Sizes: map[string]int64{"2.51.0": 100, "2.53.0": 300},
+	}
+
+	cases := []struct {
+		name     string
+		options  PruneOptions
+		state    func(PruneState) PruneState
+		expected []string
+	}{
+		{
+			name:     "keep latest keeps the active and linked versions",
+			options:  PruneOptions{KeepLatest: 2},
+			expected: []string{"2.51.0", "2.52.0"},
+		},
+		{
+			name:     "unused for",
+			options:  PruneOptions{UnusedFor: 30 * 24 * time.Hour},
+			expected: []string{"2.51.0", "2.53.0", "local-dev"},
+		},
+		{
+			name:     "both criteria must match",
+			options:  PruneOptions{KeepLatest: 1, UnusedFor: 30 * 24 * time.Hour},
+			expected: []string{"2.51.0", "2.53.0", "local-dev"},
+		},
+		{
+			name:    "aliased and pinned versions are kept",
+			options: PruneOptions{KeepLatest: 1},
+			state: func(s PruneState) PruneState {
+				s.Aliased = map[string][]string{"2.51.0": {"prod"}}
+				s.Pinned = map[string][]string{"2.52.0": {"/src/app/.jfrog-version"}}
+				return s
+			},
+			expected: []string{"2.53.0"},
+		},
+	}
+	for _, tc := range cases {
+		tc.options.Now = now
+		s := state
+		if tc.state != nil {
+			s = tc.state(state)
+		}
+		plan := planPrune(versions, s, tc.options)
+		if got := prunedVersions(plan); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, got)
+		}
+		if plan[0].Remove || plan[0].Reason != "active version" {
+			t.Errorf("%s: the active version must be kept, got %+v", tc.name, plan[0])
+		}
+	}
+}
+
+func TestShimRunPreventsPruning(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("runs the unix shim")
+	}
+	if _, err := exec.LookPath("bash"); err != nil {
+		t.Skip("the shim needs bash")
+	}
+	home := t.TempDir()
+	useJFCMRoot(t, filepath.Join(home, ".jfcm"))
+	oldShim, oldConfig := utils.JFCMShim, utils.JFCMConfig
+	utils.JFCMShim, utils.JFCMConfig = filepath.Join(utils.JFCMRoot, "shim"), filepath.Join(utils.JFCMRoot, utils.ConfigFile)
+	defer func() { utils.JFCMShim, utils.JFCMConfig = oldShim, oldConfig }()
+
+	writeFakeVersion(t, "2.50.0")
+	if _, err := utils.WriteVersionMeta("2.50.0", utils.VersionMeta{InstalledAt: time.Now().AddDate(0, 0, -90)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(utils.JFCMConfig, []byte("2.50.0"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := utils.SetupShim(); err != nil {
+		t.Fatal(err)
+	}
+
+	options := PruneOptions{UnusedFor: 30 * 24 * time.Hour, Now: time.Now()}
+	plan := func() []string {
+		return prunedVersions(planPrune([]string{"2.50.0"}, PruneState{LastUsed: versionLastUsed([]string{"2.50.0"}, true)}, options))
+	}
+	if got := plan(); !reflect.DeepEqual(got, []string{"2.50.0"}) {
+		t.Fatalf("expected the unused version to be pruned, got %v", got)
+	}
+
+	// History is off, as in CI; the run must still count as a use
+	shim := exec.Command(filepath.Join(utils.JFCMShim, utils.BinaryName), "--version")
+	shim.Env = append(os.Environ(), "HOME="+home, "jfcm_NO_HISTORY=1")
+	if out, err := shim.CombinedOutput(); err != nil {
+		t.Fatalf("shim failed: %v\n%s", err, out)
+	}
+	if got := plan(); len(got) != 0 {
+		t.Errorf("expected a version run through the shim to be kept, got %v", got)
+	}
+}
+
+func TestPruneDryRunAndLocking(t *testing.T) {
+	useJFCMRoot(t, t.TempDir())
+	writeFakeVersion(t, "2.50.0")
+	installed := time.Now().AddDate(0, 0, -90).Truncate(time.Second)
+	if err := os.Chtimes(filepath.Join(utils.JFCMVersions, "2.50.0", utils.BinaryName), installed, installed); err != nil {
+		t.Fatal(err)
+	}
+
+	// A dry run falls back to the binary's time instead of backfilling meta.json
+	if got := versionLastUsed([]string{"2.50.0"}, false)["2.50.0"]; !got.Equal(installed) {
+		t.Errorf("expected the binary's modification time %v, got %v", installed, got)
+	}
+	if _, err := os.Stat(utils.VersionMetaPath("2.50.0")); !os.IsNotExist(err) {
+		t.Errorf("expected a dry run not to write metadata, got %v", err)
+	}
+
+	// Removal waits for an install of the same version to finish
+	versionLock, _, err := utils.LockVersion(context.Background(), utils.HostPlatform(), "2.50.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() { done <- removePrunedVersion("2.50.0") }()
+	select {
+	case err := <-done:
+		t.Fatalf("expected the removal to wait for the install lock, got %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	if _, err := os.Stat(filepath.Join(utils.JFCMVersions, "2.50.0")); err != nil {
+		t.Errorf("expected the version to be kept while locked: %v", err)
+	}
+	versionLock.Release()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(utils.JFCMVersions, "2.50.0")); !os.IsNotExist(err) {
+		t.Errorf("expected the version to be removed after the lock was released")
+	}
+}
+
+func TestParseAge(t *testing.T) {
+	for value, expected := range map[string]time.Duration{
+		"30d": 30 * 24 * time.Hour,
+		"2w":  14 * 24 * time.Hour,
+		"12h": 12 * time.Hour,
+	} {
+		if got, err := parseAge(value); err != nil || got != expected {
+			t.Errorf("%s: expected %v, got %v (%v)", value, expected, got, err)
+		}
+	}
+	for _, value := range []string{"", "d", "-3d", "soon", "0h"} {
+		if _, err := parseAge(value); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
+
+func TestScanPinnedVersions(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"app/.jfrog-version":                "2.52.0\n",
+		"lib/.jfrog-version":                ">=2.51.0",
+		"app/node_modules/x/.jfrog-version": "2.50.0",
+		"tools/nested/deep/.jfrog-version":  "2.52.0",
+		"unrelated/.jfrog-version.bak":      "2.53.0",
+		"constraint-miss/.jfrog-version":    ">=3.0.0",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pinned, err := scanPinnedVersions([]string{root}, []string{"2.50.0", "2.51.0", "2.52.0", "2.53.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string][]string{
+		"2.52.0": {filepath.Join(root, "app/.jfrog-version"), filepath.Join(root, "tools/nested/deep/.jfrog-version")},
+		"2.53.0": {filepath.Join(root, "lib/.jfrog-version")},
+	}
+	if !reflect.DeepEqual(pinned, expected) {
+		t.Errorf("expected %v, got %v", expected, pinned)
+	}
+}
@@ -0,0 +1,344 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Release note categories used by the consolidated changelog view
+const (
+	NoteCategoryBreaking     = "Breaking Changes"
+	NoteCategoryFeatures     = "Features"
+	NoteCategoryImprovements = "Improvements"
+	NoteCategoryBugFixes     = "Bug Fixes"
+	NoteCategoryOther        = "Other Changes"
+)
+
+// noteCategoryOrder is the display order of the consolidated view
+var noteCategoryOrder = []string{
+	NoteCategoryBreaking,
+	NoteCategoryFeatures,
+	NoteCategoryImprovements,
+	NoteCategoryBugFixes,
+	NoteCategoryOther,
+}
+
+var (
+	// boldHeadingPattern matches a line that is only bold text, which some releases use as a heading
+	boldHeadingPattern = regexp.MustCompile(`^\*\*([^*]+)\*\*:?$`)
+
+	// conventionalPrefixPattern matches conventional commit prefixes such as "feat(rt)!:"
+	conventionalPrefixPattern = regexp.MustCompile(`^([a-zA-Z]+)(\([^)]*\))?(!)?:`)
+)
+
+// ReleaseNoteEntry is a single bullet of a release body together with the release it came from
+type ReleaseNoteEntry struct {
+	Tag      string
+	Section  string
+	Category string
+	Text     string
+}
+
+// parseReleaseNoteEntries splits a markdown release body into bullet entries. Entries are
+// categorised by the heading they appear under or, below generic headings such as
+// "What's Changed", by their conventional commit prefix.
+func parseReleaseNoteEntries(tag, body string) []ReleaseNoteEntry {
+	var entries []ReleaseNoteEntry
+	section := ""
+	sectionCategory := ""
+
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		if heading, ok := parseNoteHeading(trimmed); ok {
+			section = heading
+			sectionCategory = categorizeHeading(heading)
+			continue
+		}
+
+		text, ok := parseNoteBullet(trimmed)
+		if !ok {
+			continue
+		}
+
+		category := categorizeEntry(text)
+		if category != NoteCategoryBreaking && sectionCategory != "" {
+			category = sectionCategory
+		}
+
+		entries = append(entries, ReleaseNoteEntry{Tag: tag, Section: section, Category: category, Text: text})
+	}
+
+	return entries
+}
+
+// parseNoteHeading returns the text of a markdown or bold-only heading line
+func parseNoteHeading(line string) (string, bool) {
+	if strings.HasPrefix(line, "#") {
+		return strings.TrimSpace(strings.TrimLeft(line, "#")), true
+	}
+	if match := boldHeadingPattern.FindStringSubmatch(line); match != nil {
+		return strings.TrimSpace(match[1]), true
+	}
+	return "", false
+}
+
+// parseNoteBullet returns the text of a markdown list item
+func parseNoteBullet(line string) (string, bool) {
+	for _, marker := range []string{"- ", "* ", "+ "} {
+		if strings.HasPrefix(line, marker) {
+			return strings.TrimSpace(line[len(marker):]), true
+		}
+	}
+	return "", false
+}
+
+// categorizeHeading maps a section heading to a category, or "" for generic headings
+func categorizeHeading(heading string) string {
+	lower := strings.ToLower(heading)
+	switch {
+	case strings.Contains(lower, "breaking"):
+		return NoteCategoryBreaking
+	case strings.Contains(lower, "feature") || strings.HasPrefix(lower, "new"):
+		return NoteCategoryFeatures
+	case strings.Contains(lower, "improve") || strings.Contains(lower, "enhance"):
+		return NoteCategoryImprovements
+	case strings.Contains(lower, "fix") || strings.Contains(lower, "bug"):
+		return NoteCategoryBugFixes
+	}
+	return ""
+}
+
+// categorizeEntry classifies a single entry by its conventional commit prefix or wording
+func categorizeEntry(text string) string {
+	lower := strings.ToLower(text)
+	if strings.Contains(lower, "breaking change") {
+		return NoteCategoryBreaking
+	}
+
+	match := conventionalPrefixPattern.FindStringSubmatch(text)
+	if match == nil {
+		return NoteCategoryOther
+	}
+	if match[3] == "!" {
+		return NoteCategoryBreaking
+	}
+	switch strings.ToLower(match[1]) {
+	case "feat", "feature":
+		return NoteCategoryFeatures
+	case "fix", "bugfix", "hotfix":
+		return NoteCategoryBugFixes
+	case "perf", "improvement", "refactor":
+		return NoteCategoryImprovements
+	}
+	return NoteCategoryOther
+}
+
+// consolidateReleaseNotes groups the entries of all releases by category, preserving release order
+func consolidateReleaseNotes(notes []noteResult) map[string][]ReleaseNoteEntry {
+	grouped := make(map[string][]ReleaseNoteEntry)
+	for _, note := range notes {
+		for _, entry := range parseReleaseNoteEntries(note.Tag, note.Body) {
+			grouped[entry.Category] = append(grouped[entry.Category], entry)
+		}
+	}
+	return grouped
+}
+
+// DisplayConsolidatedChangelog displays the entries of all releases in the range grouped by section
+func DisplayConsolidatedChangelog(releaseNotes []noteResult, version1, version2 string, noColor bool) {
+	colors := NewColorScheme(noColor)
+
+	fmt.Printf("╔══════════════════════════════════════════════════════════════════════════════════════╗\n")
+	fmt.Printf("║                           📖 CONSOLIDATED CHANGELOG                                   ║\n")
+	fmt.Printf("╚══════════════════════════════════════════════════════════════════════════════════════╝\n\n")
+
+	if len(releaseNotes) == 0 {
+		fmt.Printf("ℹ️  No release notes found between versions %s and %s\n", version1, version2)
+		return
+	}
+
+	grouped := consolidateReleaseNotes(releaseNotes)
+	total := 0
+	for _, category := range noteCategoryOrder {
+		entries := grouped[category]
+		if len(entries) == 0 {
+			continue
+		}
+		total += len(entries)
+
+		fmt.Printf("  %s\n", colors.Magenta.Sprintf("## %s (%d)", category, len(entries)))
+		for _, entry := range entries {
+			fmt.Printf("    %s %s\n", colors.Cyan.Sprint("- "+entry.Text), colors.Blue.Sprintf("[%s]", entry.Tag))
+		}
+		fmt.Printf("\n")
+	}
+
+	if total == 0 {
+		fmt.Printf("  📝 The releases in this range have no itemised notes.\n\n")
+	}
+
+	fmt.Printf("╔══════════════════════════════════════════════════════════════════════════════════════╗\n")
+	fmt.Printf("║ ✅ Summary: %d change(s) across %d release(s) between %s → %s\n",
+		total,
+		len(releaseNotes),
+		colors.Blue.Sprint(version1),
+		colors.Blue.Sprint(version2))
+	fmt.Printf("╚══════════════════════════════════════════════════════════════════════════════════════╝\n")
+}
+
+// ChangelogMatch is a release note line selected by --grep or --breaking
+type ChangelogMatch struct {
+	Tag     string
+	Section string
+	Text    string
+	Reason  string
+}
+
+// breakingPatterns flag entries that are not tagged as breaking but still break existing usage
+var breakingPatterns = []struct {
+	Reason  string
+	Pattern *regexp.Regexp
+}{
+	{"deprecated", regexp.MustCompile(`(?i)\bdeprecat`)},
+	// "Removed the --x flag", "Dropped support for the y flag" and "The --x flag was removed", but not
+	// entries that only mention a flag next to a removal ("Removed a log line when --verbose is set")
+	{"flag removed", regexp.MustCompile(`(?i)\b(remov(e|es|ed|ing)|drop(s|ped|ping)?)\s+(support\s+for\s+)?(the\s+)?(--?[a-z][\w-]*|[\w-]+\s+flags?\b)` +
+		`|(^|\s)(--?[a-z][\w-]*(\s+flags?)?|[\w-]+\s+flags?)\s+(is|are|was|were|has\s+been|have\s+been)\s+(remov|dropp)ed\b`)},
+	{"no longer supported", regexp.MustCompile(`(?i)\bno longer (supported|available|accepted)\b`)},
+}
+
+// searchReleaseNotes returns every line of the release bodies containing term (case-insensitive)
+func searchReleaseNotes(notes []noteResult, term string) []ChangelogMatch {
+	var matches []ChangelogMatch
+	lowerTerm := strings.ToLower(term)
+
+	for _, note := range notes {
+		section := ""
+		for _, line := range strings.Split(note.Body, "\n") {
+			trimmed := strings.TrimSpace(line)
+			if heading, ok := parseNoteHeading(trimmed); ok {
+				section = heading
+				continue
+			}
+			if !strings.Contains(strings.ToLower(trimmed), lowerTerm) {
+				continue
+			}
+			if text, ok := parseNoteBullet(trimmed); ok {
+				trimmed = text
+			}
+			matches = append(matches, ChangelogMatch{Tag: note.Tag, Section: section, Text: trimmed})
+		}
+	}
+
+	return matches
+}
+
+// findBreakingChanges returns the entries tagged as breaking, deprecations and removals of flags
+func findBreakingChanges(notes []noteResult) []ChangelogMatch {
+	var matches []ChangelogMatch
+
+	for _, note := range notes {
+		for _, entry := range parseReleaseNoteEntries(note.Tag, note.Body) {
+			reason := ""
+			if entry.Category == NoteCategoryBreaking {
+				reason = "breaking change"
+			} else {
+				for _, candidate := range breakingPatterns {
+					if candidate.Pattern.MatchString(entry.Text) {
+						reason = candidate.Reason
+						break
+					}
+				}
+			}
+			if reason == "" {
+				continue
+			}
+			matches = append(matches, ChangelogMatch{Tag: entry.Tag, Section: entry.Section, Text: entry.Text, Reason: reason})
+		}
+	}
+
+	return matches
+}
+
+// filterChangelogMatches keeps the matches whose text contains term (case-insensitive)
+func filterChangelogMatches(matches []ChangelogMatch, term string) []ChangelogMatch {
+	var filtered []ChangelogMatch
+	for _, match := range matches {
+		if strings.Contains(strings.ToLower(match.Text), strings.ToLower(term)) {
+			filtered = append(filtered, match)
+		}
+	}
+	return filtered
+}
+
+// matchedVersions returns the distinct release tags of the matches in order of appearance
+func matchedVersions(matches []ChangelogMatch) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, match := range matches {
+		if !seen[match.Tag] {
+			seen[match.Tag] = true
+			tags = append(tags, match.Tag)
+		}
+	}
+	return tags
+}
+
+// highlightTerm colors every case-insensitive occurrence of term in text
+func highlightTerm(text, term string, colors *ColorScheme) string {
+	if term == "" {
+		return text
+	}
+	pattern := regexp.MustCompile(`(?i)` + regexp.QuoteMeta(term))
+	return pattern.ReplaceAllStringFunc(text, func(match string) string {
+		return colors.Yellow.Sprint(match)
+	})
+}
+
+// DisplayChangelogMatches displays --grep and --breaking results grouped by release
+func DisplayChangelogMatches(title string, matches []ChangelogMatch, term string, releaseCount int, version1, version2 string, noColor bool) {
+	colors := NewColorScheme(noColor)
+
+	fmt.Printf("%s between %s and %s (%d release(s) searched)\n\n",
+		title,
+		colors.Blue.Sprint(version1),
+		colors.Blue.Sprint(version2),
+		releaseCount)
+
+	if len(matches) == 0 {
+		fmt.Printf("ℹ️  No matching release notes found\n")
+		return
+	}
+
+	currentTag := ""
+	for _, match := range matches {
+		if match.Tag != currentTag {
+			if currentTag != "" {
+				fmt.Printf("\n")
+			}
+			currentTag = match.Tag
+			fmt.Printf("%s\n", colors.Green.Sprintf("📦 %s", match.Tag))
+		}
+
+		line := "    - " + highlightTerm(match.Text, term, colors)
+		var details []string
+		if match.Reason != "" {
+			details = append(details, colors.Red.Sprint(match.Reason))
+		}
+		if match.Section != "" {
+			details = append(details, match.Section)
+		}
+		if len(details) > 0 {
+			line += fmt.Sprintf("  (%s)", strings.Join(details, ", "))
+		}
+		fmt.Println(line)
+	}
+
+	tags := matchedVersions(matches)
+	fmt.Printf("\n✅ %d match(es) in %d release(s): %s\n", len(matches), len(tags), strings.Join(tags, ", "))
+}
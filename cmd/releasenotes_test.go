@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestParseReleaseNoteEntries(t *testing.T) {
+	body := `## New Features 🚀
+- Added rt curl timeout flag
+## Bug Fixes 🛠
+* Fixed build-info collection on Windows
+## What's Changed
+* feat(rt): support remote repos by @dev in https://github.com/jfrog/jfrog-cli/pull/1
+* fix: retry on 503 by @dev in https://github.com/jfrog/jfrog-cli/pull/2
+* refactor!: drop the --legacy flag by @dev in https://github.com/jfrog/jfrog-cli/pull/3
+* Bump dependencies
+**Full Changelog**: https://github.com/jfrog/jfrog-cli/compare/v2.1.0...v2.2.0`
+
+	entries := parseReleaseNoteEntries("v2.2.0", body)
+	want := []string{
+		NoteCategoryFeatures,
+		NoteCategoryBugFixes,
+		NoteCategoryFeatures,
+		NoteCategoryBugFixes,
+		NoteCategoryBreaking,
+		NoteCategoryOther,
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("expected %d entries, got %d: %+v", len(want), len(entries), entries)
+	}
+	for i, category := range want {
+		if entries[i].Category != category {
+			t.Errorf("entry %q: category = %q, want %q", entries[i].Text, entries[i].Category, category)
+		}
+		if entries[i].Tag != "v2.2.0" {
+			t.Errorf("entry %q: tag = %q", entries[i].Text, entries[i].Tag)
+		}
+	}
+}
+
+func TestSearchAndBreakingChanges(t *testing.T) {
+	notes := []noteResult{
+		{Tag: "v2.55.0", Body: "## What's Changed\n* fix: build-info collection for npm\n* The --legacy-upload flag was removed"},
+		{Tag: "v2.56.0", Body: "## Breaking Changes\n- rt ping requires a server id\n## Improvements\n- Deprecated jfrog rt build-info command"},
+		{Tag: "v2.57.0", Body: "## Features\n- New BUILD-INFO attributes"},
+	}
+
+	matches := searchReleaseNotes(notes, "build-info")
+	if got := matchedVersions(matches); len(got) != 3 {
+		t.Errorf("expected build-info in 3 releases, got %v", got)
+	}
+
+	breaking := findBreakingChanges(notes)
+	reasons := map[string]string{}
+	for _, match := range breaking {
+		reasons[match.Text] = match.Tag + " " + match.Reason
+	}
+	want := map[string]string{
+		"The --legacy-upload flag was removed":   "v2.55.0 flag removed",
+		"rt ping requires a server id":           "v2.56.0 breaking change",
+		"Deprecated jfrog rt build-info command": "v2.56.0 deprecated",
+	}
+	if len(reasons) != len(want) {
+		t.Fatalf("expected %d breaking entries, got %v", len(want), reasons)
+	}
+	for text, expected := range want {
+		if reasons[text] != expected {
+			t.Errorf("%q: got %q, want %q", text, reasons[text], expected)
+		}
+	}
+
+	if filtered := filterChangelogMatches(breaking, "BUILD-INFO"); len(filtered) != 1 || filtered[0].Tag != "v2.56.0" {
+		t.Errorf("unexpected filtered breaking changes: %+v", filtered)
+	}
+}
+
+func TestBreakingFlagPattern(t *testing.T) {
+	var flagRemoved *regexp.Regexp
+	for _, pattern := range breakingPatterns {
+		if pattern.Reason == "flag removed" {
+			flagRemoved = pattern.Pattern
+		}
+	}
+	for text, want := range map[string]bool{
+		"The --legacy-upload flag was removed":                 true,
+		"Removed the --insecure-tls flag":                      true,
+		"remove -x from jf rt upload":                          true,
+		"Dropped support for the threads flag":                 true,
+		"--fail-no-op has been removed":                        true,
+		"Removed a duplicate log line when --verbose is set":   false,
+		"Fix drop support for the legacy option parsing bug":   false,
+		"Removing temporary files no longer needs the --force": false,
+		"fix: build-info was removed from the summary":         false,
+	} {
+		if got := flagRemoved.MatchString(text); got != want {
+			t.Errorf("%q: matched %t, want %t", text, got, want)
+		}
+	}
+}
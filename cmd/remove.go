@@ -5,25 +5,62 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/jfrog/jfrog-cli-manager/cmd/descriptions"
 	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
 	"github.com/urfave/cli/v2"
 )
 
 var Remove = &cli.Command{
-	Name:      "remove",
-	Usage:     "Remove an installed JFrog CLI version",
-	ArgsUsage: "[version]",
-	Action: func(c *cli.Context) error {
+	Name:        "remove",
+	Usage:       descriptions.Remove.Usage,
+	ArgsUsage:   "[version]",
+	Description: descriptions.Remove.Format(),
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:    "force",
+			Aliases: []string{"f"},
+			Usage:   "Remove the active or an aliased version without asking",
+		},
+	},
+	Action: withStateLock(func(c *cli.Context) error {
 		if c.Args().Len() != 1 {
 			return cli.Exit("Please provide a version to remove", 1)
 		}
 		version := c.Args().Get(0)
+		if err := utils.ValidateVersionDirName(version); err != nil {
+			return cli.Exit(fmt.Sprintf("❌ %v", err), 1)
+		}
 		dir := filepath.Join(utils.JFCMVersions, version)
 
-		if _, err := os.Stat(dir); os.IsNotExist(err) {
+		if info, err := os.Lstat(dir); err != nil || !info.IsDir() {
 			return fmt.Errorf("version %s is not installed", version)
 		}
 
-		return os.RemoveAll(dir)
-	},
+		guards, err := versionGuards([]string{version})
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+		var warnings []string
+		wasActive := false
+		for _, guard := range guards {
+			warnings = append(warnings, guard.Warnings()...)
+			wasActive = wasActive || guard.Active
+		}
+		if err := confirmRemoval(warnings, fmt.Sprintf("Remove %s anyway?", version), c.Bool("force")); err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		if err := os.RemoveAll(dir); err != nil {
+			return fmt.Errorf("failed to remove %s: %w", version, err)
+		}
+		fmt.Printf("✅ Removed %s\n", version)
+
+		if wasActive {
+			if err := offerSwitch(version); err != nil {
+				return cli.Exit(err.Error(), 1)
+			}
+		}
+		reportDanglingAliases()
+		return nil
+	}),
 }
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+	"github.com/urfave/cli/v2"
+)
+
+func TestRemoveRejectsPathsOutsideVersions(t *testing.T) {
+	root := t.TempDir()
+	useJFCMRoot(t, filepath.Join(root, "jfcm"))
+	for _, dir := range []string{filepath.Join(utils.JFCMVersions, "2.50.0"), filepath.Join(root, "outside")} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(utils.JFCMVersions, "notes.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	app := &cli.App{
+		Commands:       []*cli.Command{Remove},
+		ExitErrHandler: func(*cli.Context, error) {},
+	}
+	cases := map[string]string{
+		"..":            "invalid version name",
+		".":             "invalid version name",
+		"../../outside": "invalid version name",
+		"2.50.0/..":     "invalid version name",
+		`..\outside`:    "invalid version name",
+		"notes.txt":     "not installed",
+		"2.99.0":        "not installed",
+	}
+	for version, message := range cases {
+		err := app.Run([]string{"jfcm", "remove", "--force", version})
+		if err == nil || !strings.Contains(err.Error(), message) {
+			t.Errorf("%s: expected %q error, got %v", version, message, err)
+		}
+	}
+	for _, dir := range []string{filepath.Join(utils.JFCMVersions, "2.50.0"), filepath.Join(root, "outside")} {
+		if _, err := os.Stat(dir); err != nil {
+			t.Errorf("expected %s to be kept: %v", dir, err)
+		}
+	}
+}
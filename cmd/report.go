@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Supported output formats for compare cli and compare rt
+const (
+	CompareFormatTable    = "table"
+	CompareFormatJSON     = "json"
+	CompareFormatMarkdown = "markdown"
+	CompareFormatJUnit    = "junit"
+)
+
+// ComparisonReport is the machine-readable result of a compare cli/rt run
+type ComparisonReport struct {
+	Type        string             `json:"type"`
+	Command     string             `json:"command"`
+	Timestamp   time.Time          `json:"timestamp"`
+	Results     []ExecutionSummary `json:"results"`
+	Comparisons []PairComparison   `json:"comparisons"`
+	Differs     bool               `json:"differs"`
+}
+
+// ExecutionSummary is the serializable form of an ExecutionResult
+type ExecutionSummary struct {
+	Target     string  `json:"target"`
+	ExitCode   int     `json:"exit_code"`
+	DurationMs float64 `json:"duration_ms"`
+	Output     string  `json:"output"`
+	Stderr     string  `json:"stderr,omitempty"`
+}
+
+// PairComparison describes the differences between the baseline and one other target
+type PairComparison struct {
+	Baseline        string     `json:"baseline"`
+	Target          string     `json:"target"`
+	ExitCodeDiffers bool       `json:"exit_code_differs"`
+	OutputDiffers   bool       `json:"output_differs"`
+	Diff            []DiffLine `json:"diff,omitempty"`
+}
+
+// DiffLine is a single added or removed line in a pair comparison
+type DiffLine struct {
+	Line int    `json:"line"`
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Differs reports whether the pair has any exit code or normalized output difference
+func (p PairComparison) Differs() bool {
+	return p.ExitCodeDiffers || p.OutputDiffers
+}
+
+// validateCompareFormat checks that the requested report format is supported
+func validateCompareFormat(format string) error {
+	switch format {
+	case CompareFormatTable, CompareFormatJSON, CompareFormatMarkdown, CompareFormatJUnit:
+		return nil
+	}
+	return fmt.Errorf("unsupported format '%s' (supported: table, json, markdown, junit)", format)
+}
+
+// buildComparisonReport compares every result against the first one (the baseline)
+func buildComparisonReport(kind string, results []ExecutionResult, normalizer *OutputNormalizer) ComparisonReport {
+	report := ComparisonReport{
+		Type:      kind,
+		Timestamp: time.Now(),
+	}
+	if len(results) == 0 {
+		return report
+	}
+	report.Command = results[0].Command
+
+	for _, result := range results {
+		report.Results = append(report.Results, ExecutionSummary{
+			Target:     result.Version,
+			ExitCode:   result.ExitCode,
+			DurationMs: float64(result.Duration.Nanoseconds()) / 1e6,
+			Output:     result.Output,
+			Stderr:     result.ErrorMsg,
+		})
+	}
+
+	baseline := results[0]
+	for _, result := range results[1:] {
+		output1, output2 := prepareOutputsForComparison(baseline, result, normalizer)
+		pair := PairComparison{
+			Baseline:        baseline.Version,
+			Target:          result.Version,
+			ExitCodeDiffers: baseline.ExitCode != result.ExitCode,
+			OutputDiffers: output1 != output2 ||
+				normalizer.Normalize(baseline.ErrorMsg) != normalizer.Normalize(result.ErrorMsg),
+		}
+		if output1 != output2 {
+			for _, change := range computeLineDiff(output1, output2) {
+				if change.changeType == "context" {
+					continue
+				}
+				pair.Diff = append(pair.Diff, DiffLine{Line: change.lineNum, Type: change.changeType, Text: change.text})
+			}
+		}
+		if pair.Differs() {
+			report.Differs = true
+		}
+		report.Comparisons = append(report.Comparisons, pair)
+	}
+
+	return report
+}
+
+// writeComparisonReport renders the report in one of the machine-readable formats
+func writeComparisonReport(w io.Writer, report ComparisonReport, format string) error {
+	switch format {
+	case CompareFormatJSON:
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode report: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case CompareFormatMarkdown:
+		return writeComparisonMarkdown(w, report)
+	case CompareFormatJUnit:
+		return writeComparisonJUnit(w, report)
+	}
+	return validateCompareFormat(format)
+}
+
+func writeComparisonMarkdown(w io.Writer, report ComparisonReport) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## jfcm compare %s\n\n", report.Type)
+	fmt.Fprintf(&b, "Command: `jf %s`\n\n", report.Command)
+
+	b.WriteString("| Target | Exit Code | Duration |\n")
+	b.WriteString("|--------|-----------|----------|\n")
+	for _, result := range report.Results {
+		fmt.Fprintf(&b, "| %s | %d | %.2fms |\n", result.Target, result.ExitCode, result.DurationMs)
+	}
+	b.WriteString("\n")
+
+	for _, pair := range report.Comparisons {
+		status := "✅ identical"
+		if pair.Differs() {
+			status = "❌ differs"
+		}
+		fmt.Fprintf(&b, "### %s vs %s: %s\n\n", pair.Baseline, pair.Target, status)
+		if pair.ExitCodeDiffers {
+			b.WriteString("- Exit codes differ\n")
+		}
+		if len(pair.Diff) > 0 {
+			b.WriteString("\n```diff\n")
+			for _, line := range pair.Diff {
+				prefix := "+"
+				if line.Type == "removed" {
+					prefix = "-"
+				}
+				fmt.Fprintf(&b, "%s %s\n", prefix, line.Text)
+			}
+			b.WriteString("```\n")
+		}
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Body    string `xml:",chardata"`
+}
+
+func writeComparisonJUnit(w io.Writer, report ComparisonReport) error {
+	suite := junitTestSuite{
+		Name:      fmt.Sprintf("jfcm compare %s: jf %s", report.Type, report.Command),
+		Timestamp: report.Timestamp.Format(time.RFC3339),
+	}
+
+	durations := make(map[string]float64)
+	for _, result := range report.Results {
+		durations[result.Target] = result.DurationMs
+	}
+
+	for _, pair := range report.Comparisons {
+		testCase := junitTestCase{
+			Name:      fmt.Sprintf("%s vs %s", pair.Baseline, pair.Target),
+			ClassName: "jfcm.compare." + report.Type,
+			Time:      fmt.Sprintf("%.3f", (durations[pair.Baseline]+durations[pair.Target])/1000),
+		}
+
+		if pair.Differs() {
+			var reasons []string
+			if pair.ExitCodeDiffers {
+				reasons = append(reasons, "exit codes differ")
+			}
+			if pair.OutputDiffers {
+				reasons = append(reasons, "outputs differ")
+			}
+
+			var body strings.Builder
+			for _, line := range pair.Diff {
+				prefix := "+"
+				if line.Type == "removed" {
+					prefix = "-"
+				}
+				fmt.Fprintf(&body, "%s %s\n", prefix, line.Text)
+			}
+
+			testCase.Failure = &junitFailure{
+				Message: strings.Join(reasons, ", "),
+				Type:    "ComparisonDifference",
+				Body:    body.String(),
+			}
+			suite.Failures++
+		}
+
+		suite.Cases = append(suite.Cases, testCase)
+		suite.Tests++
+	}
+
+	suites := junitTestSuites{
+		Suites:   []junitTestSuite{suite},
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+	}
+
+	data, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode JUnit report: %w", err)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
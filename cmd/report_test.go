@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestOutputNormalizer(t *testing.T) {
+	normalizer, err := NewOutputNormalizer([]string{`\d{4}-\d{2}-\d{2}`})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := normalizer.Normalize("\x1b[32mok\x1b[0m   \r\nbuilt on 2025-01-31\r\n")
+	want := "ok\nbuilt on " + IgnoredPlaceholder
+	if got != want {
+		t.Errorf("Normalize() = %q, want %q", got, want)
+	}
+
+	got = normalizer.Normalize("home=/tmp/jfcm-jfhome-123456/jfrog-cli.conf.v6")
+	want = "home=" + JFHomePlaceholder + "/jfrog-cli.conf.v6"
+	if got != want {
+		t.Errorf("Normalize() = %q, want %q", got, want)
+	}
+
+	if _, err := NewOutputNormalizer([]string{"("}); err == nil {
+		t.Error("expected an error for an invalid pattern")
+	}
+}
+
+func TestBuildComparisonReport(t *testing.T) {
+	results := []ExecutionResult{
+		{Version: "2.74.0", Command: "rt ping", Output: "OK\n"},
+		{Version: "2.77.0", Command: "rt ping", Output: "\x1b[1mOK\x1b[0m"},
+		{Version: "2.78.0", Command: "rt ping", Output: "FAILED", ExitCode: 1},
+	}
+
+	report := buildComparisonReport("cli", results, &OutputNormalizer{})
+	if !report.Differs {
+		t.Fatal("expected report to differ")
+	}
+	if len(report.Comparisons) != 2 {
+		t.Fatalf("expected 2 comparisons, got %d", len(report.Comparisons))
+	}
+	if report.Comparisons[0].Differs() {
+		t.Errorf("expected normalized outputs of %s and %s to match", results[0].Version, results[1].Version)
+	}
+	second := report.Comparisons[1]
+	if !second.ExitCodeDiffers || !second.OutputDiffers {
+		t.Errorf("expected exit code and output differences, got %+v", second)
+	}
+}
+
+func TestWriteComparisonReportJUnit(t *testing.T) {
+	results := []ExecutionResult{
+		{Version: "a", Command: "--version", Output: "1"},
+		{Version: "b", Command: "--version", Output: "2"},
+	}
+	report := buildComparisonReport("cli", results, &OutputNormalizer{})
+
+	var buf bytes.Buffer
+	if err := writeComparisonReport(&buf, report, CompareFormatJUnit); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := buf.String()
+	for _, expected := range []string{`<testsuites tests="1" failures="1">`, `name="a vs b"`, "- 1", "+ 2"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("JUnit output missing %q:\n%s", expected, out)
+		}
+	}
+}
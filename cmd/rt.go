@@ -1,102 +1,118 @@
 package cmd
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"os/exec"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
 )
 
-// validateRTArguments validates RT-specific arguments and returns server names and command parts
-func validateRTArguments(args []string) (string, string, []string, error) {
+// ServerTarget identifies a configured server ID and the jf version used to query it
+type ServerTarget struct {
+	ServerID string
+	Version  string // Version or alias as given by the user, empty for the active version
+	Resolved string // Installed version used for execution
+}
+
+// Key returns the "server@version" label used to key results
+func (t ServerTarget) Key() string {
+	return t.ServerID + "@" + t.Resolved
+}
+
+// parseServerTarget splits a "server[@version]" argument into a ServerTarget
+func parseServerTarget(arg string) (ServerTarget, error) {
+	serverID, version, hasVersion := strings.Cut(arg, "@")
+	if serverID == "" {
+		return ServerTarget{}, fmt.Errorf("missing server ID in '%s'", arg)
+	}
+	if hasVersion && version == "" {
+		return ServerTarget{}, fmt.Errorf("missing version after '@' in '%s'", arg)
+	}
+	return ServerTarget{ServerID: serverID, Version: version}, nil
+}
+
+// validateRTArguments validates RT-specific arguments and returns server targets and command parts
+func validateRTArguments(args []string) ([]ServerTarget, []string, error) {
 	if len(args) < 3 {
-		return "", "", nil, fmt.Errorf("insufficient arguments: need <server1> <server2> -- <command>")
+		return nil, nil, fmt.Errorf("insufficient arguments: need <server1> <server2> -- <command>")
 	}
 
 	// Find the separator "--"
 	separatorIndex := findSeparator(args, "--")
 	if separatorIndex == -1 {
-		return "", "", nil, fmt.Errorf("missing '--' separator")
+		return nil, nil, fmt.Errorf("missing '--' separator")
 	}
 
 	// Separator must be after at least 2 arguments (server1 and server2)
 	if separatorIndex < 2 {
-		return "", "", nil, fmt.Errorf("'--' separator must come after <server1> <server2>")
+		return nil, nil, fmt.Errorf("'--' separator must come after <server1> <server2>")
 	}
 
 	if len(args) <= separatorIndex+1 {
-		return "", "", nil, fmt.Errorf("no command specified after '--'")
+		return nil, nil, fmt.Errorf("no command specified after '--'")
 	}
 
-	server1 := args[0]
-	server2 := args[1]
-	jfCommand := args[separatorIndex+1:]
+	var targets []ServerTarget
+	for _, arg := range args[:separatorIndex] {
+		target, err := parseServerTarget(arg)
+		if err != nil {
+			return nil, nil, err
+		}
+		targets = append(targets, target)
+	}
 
-	return server1, server2, jfCommand, nil
+	return targets, args[separatorIndex+1:], nil
 }
 
-// executeJFCommandOnServer executes a JFrog CLI command on the specified server
-func executeJFCommandOnServer(ctx context.Context, serverName string, jfCommand []string) (ExecutionResult, error) {
+// resolveServerTargets resolves each target's version (or the active version) to an installed version
+func resolveServerTargets(targets []ServerTarget) error {
+	seen := make(map[string]bool)
+	for i := range targets {
+		target := &targets[i]
+
+		if target.Version == "" {
+			activeVersion, err := utils.GetActiveVersion()
+			if err != nil {
+				return fmt.Errorf("server %s has no version and the active version is unavailable: %w", target.ServerID, err)
+			}
+			target.Resolved = activeVersion
+		} else {
+			resolved, err := utils.ResolveVersionOrAlias(target.Version)
+			if err != nil {
+				resolved = target.Version
+			}
+			target.Resolved = resolved
+		}
+
+		if err := utils.CheckVersionExists(target.Resolved); err != nil {
+			return fmt.Errorf("version %s for server %s not found: %w", target.Resolved, target.ServerID, err)
+		}
+
+		if seen[target.Key()] {
+			return fmt.Errorf("duplicate target %s", target.Key())
+		}
+		seen[target.Key()] = true
+	}
+	return nil
+}
+
+// executeJFCommandOnServer executes a JFrog CLI command on the specified server with the target's version
+func executeJFCommandOnServer(ctx context.Context, target ServerTarget, jfCommand []string, env []string) (ExecutionResult, error) {
 	result := ExecutionResult{
-		Version:   serverName, // Use server name as "version" for display purposes
+		Version:   target.Key(), // Use server@version as "version" for display purposes
 		Command:   strings.Join(jfCommand, " "),
 		StartTime: time.Now(),
 	}
 
-	// Get the active jf binary path
-	binaryPath, err := utils.GetActiveBinaryPath()
-	if err != nil {
-		result.ErrorMsg = fmt.Sprintf("Failed to get active jf binary: %v", err)
-		result.ExitCode = 1
-		result.Duration = time.Since(result.StartTime)
-		return result, err
-	}
+	binaryPath := filepath.Join(utils.JFCMVersions, target.Resolved, utils.BinaryName)
 
 	// Add --server-id as a global flag before the subcommand for broad compatibility
-	commandArgs := append([]string{"--server-id", serverName}, jfCommand...)
-
-	// Execute the command with --server-id flag
-	cmd := exec.CommandContext(ctx, binaryPath, commandArgs...)
-
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	err = cmd.Run()
-	result.Duration = time.Since(result.StartTime)
-
-	stdoutStr := stdout.String()
-	stderrStr := stderr.String()
+	commandArgs := append([]string{"--server-id", target.ServerID}, jfCommand...)
 
-	if err != nil {
-		if exitError, ok := err.(*exec.ExitError); ok {
-			result.ExitCode = exitError.ExitCode()
-		} else {
-			result.ExitCode = 1
-		}
-		result.ErrorMsg = stderrStr
-
-		// For failed commands, still capture stdout as output (help commands often write to stdout even on failure)
-		if stdoutStr != "" {
-			result.Output = stdoutStr
-		} else if stderrStr != "" {
-			result.Output = stderrStr
-		}
-	} else {
-		// When command succeeds, combine stdout and stderr for output comparison
-		// Many CLI tools write informational messages to stderr even on success
-		if stdoutStr != "" && stderrStr != "" {
-			result.Output = stdoutStr + "\n" + stderrStr
-		} else if stdoutStr != "" {
-			result.Output = stdoutStr
-		} else {
-			result.Output = stderrStr
-		}
-	}
+	runJFBinary(ctx, &result, binaryPath, commandArgs, env)
 
 	return result, nil
 }
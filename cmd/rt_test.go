@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+)
+
+func TestParseServerTarget(t *testing.T) {
+	valid := map[string]ServerTarget{
+		"prod@2.74.0":  {ServerID: "prod", Version: "2.74.0"},
+		"prod@stable":  {ServerID: "prod", Version: "stable"},
+		"prod":         {ServerID: "prod"},
+		"a@b@2.74.0":   {ServerID: "a", Version: "b@2.74.0"},
+		"my-server@v2": {ServerID: "my-server", Version: "v2"},
+	}
+	for arg, want := range valid {
+		got, err := parseServerTarget(arg)
+		if err != nil || got != want {
+			t.Errorf("parseServerTarget(%q) = %+v, %v; want %+v", arg, got, err, want)
+		}
+	}
+
+	invalid := map[string]string{
+		"":        "missing server ID",
+		"@2.74.0": "missing server ID",
+		"prod@":   "missing version",
+		"@":       "missing server ID",
+	}
+	for arg, message := range invalid {
+		if _, err := parseServerTarget(arg); err == nil || !strings.Contains(err.Error(), message) {
+			t.Errorf("parseServerTarget(%q): expected %q error, got %v", arg, message, err)
+		}
+	}
+}
+
+func TestResolveServerTargets(t *testing.T) {
+	root := t.TempDir()
+	useJFCMRoot(t, root)
+	oldConfig := utils.JFCMConfig
+	utils.JFCMConfig = filepath.Join(root, utils.ConfigFile)
+	defer func() { utils.JFCMConfig = oldConfig }()
+
+	for _, version := range []string{"2.74.0", "2.77.0"} {
+		if err := os.MkdirAll(filepath.Join(utils.JFCMVersions, version), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(utils.JFCMVersions, version, utils.BinaryName), []byte("jf"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(utils.JFCMAliases, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(utils.JFCMAliases, "stable"), []byte(`{"version": "2.74.0"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without an active version, a target without a version cannot be resolved
+	if err := resolveServerTargets([]ServerTarget{{ServerID: "prod"}}); err == nil || !strings.Contains(err.Error(), "active version") {
+		t.Errorf("expected an active version error, got %v", err)
+	}
+	if err := os.WriteFile(utils.JFCMConfig, []byte("2.77.0\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	targets := []ServerTarget{{ServerID: "prod", Version: "stable"}, {ServerID: "prod"}, {ServerID: "dev", Version: "2.74.0"}}
+	if err := resolveServerTargets(targets); err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []string{"prod@2.74.0", "prod@2.77.0", "dev@2.74.0"} {
+		if got := targets[i].Key(); got != want {
+			t.Errorf("target %d: expected %s, got %s", i, want, got)
+		}
+	}
+
+	cases := map[string]struct {
+		targets []ServerTarget
+		message string
+	}{
+		"alias and version of the same server": {
+			targets: []ServerTarget{{ServerID: "prod", Version: "stable"}, {ServerID: "prod", Version: "2.74.0"}},
+			message: "duplicate target prod@2.74.0",
+		},
+		"active version given twice": {
+			targets: []ServerTarget{{ServerID: "prod"}, {ServerID: "prod", Version: "2.77.0"}},
+			message: "duplicate target prod@2.77.0",
+		},
+		"version not installed": {
+			targets: []ServerTarget{{ServerID: "prod", Version: "2.60.0"}},
+			message: "version 2.60.0 for server prod not found",
+		},
+	}
+	for name, tc := range cases {
+		if err := resolveServerTargets(tc.targets); err == nil || !strings.Contains(err.Error(), tc.message) {
+			t.Errorf("%s: expected %q error, got %v", name, tc.message, err)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// ResourceUsage is the resource consumption of a finished jf process
+type ResourceUsage struct {
+	UserTime                   time.Duration
+	SystemTime                 time.Duration
+	MaxRSS                     int64 // bytes, 0 when the platform does not report it
+	VoluntaryContextSwitches   int64
+	InvoluntaryContextSwitches int64
+}
+
+// ResourceStats aggregates the resource usage of all iterations of a benchmark
+type ResourceStats struct {
+	AvgUserTime                   time.Duration
+	AvgSystemTime                 time.Duration
+	AvgMaxRSS                     int64
+	PeakMaxRSS                    int64
+	AvgVoluntaryContextSwitches   float64
+	AvgInvoluntaryContextSwitches float64
+}
+
+// processResourceUsage reads the resource usage of a process that has exited
+func processResourceUsage(state *os.ProcessState) ResourceUsage {
+	if state == nil {
+		return ResourceUsage{}
+	}
+	usage := ResourceUsage{
+		UserTime:   state.UserTime(),
+		SystemTime: state.SystemTime(),
+	}
+	fillPlatformResourceUsage(state, &usage)
+	return usage
+}
+
+// aggregateResourceUsage averages the usage samples and records the peak resident set size
+func aggregateResourceUsage(samples []ResourceUsage) ResourceStats {
+	var stats ResourceStats
+	if len(samples) == 0 {
+		return stats
+	}
+
+	var user, system time.Duration
+	var rss, voluntary, involuntary int64
+	for _, sample := range samples {
+		user += sample.UserTime
+		system += sample.SystemTime
+		rss += sample.MaxRSS
+		voluntary += sample.VoluntaryContextSwitches
+		involuntary += sample.InvoluntaryContextSwitches
+		if sample.MaxRSS > stats.PeakMaxRSS {
+			stats.PeakMaxRSS = sample.MaxRSS
+		}
+	}
+
+	n := int64(len(samples))
+	stats.AvgUserTime = user / time.Duration(n)
+	stats.AvgSystemTime = system / time.Duration(n)
+	stats.AvgMaxRSS = rss / n
+	stats.AvgVoluntaryContextSwitches = float64(voluntary) / float64(n)
+	stats.AvgInvoluntaryContextSwitches = float64(involuntary) / float64(n)
+	return stats
+}
+
+// formatBytes formats a byte count with a binary unit
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package cmd
+
+import "os"
+
+// fillPlatformResourceUsage is a no-op where rusage is not available; only CPU times are reported
+func fillPlatformResourceUsage(state *os.ProcessState, usage *ResourceUsage) {}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAggregateResourceUsage(t *testing.T) {
+	stats := aggregateResourceUsage([]ResourceUsage{
+		{UserTime: 10 * time.Millisecond, SystemTime: 2 * time.Millisecond, MaxRSS: 40 << 20, VoluntaryContextSwitches: 3},
+		{UserTime: 20 * time.Millisecond, SystemTime: 4 * time.Millisecond, MaxRSS: 60 << 20, InvoluntaryContextSwitches: 5},
+	})
+
+	if stats.AvgUserTime != 15*time.Millisecond || stats.AvgSystemTime != 3*time.Millisecond {
+		t.Errorf("unexpected CPU averages: %+v", stats)
+	}
+	if stats.AvgMaxRSS != 50<<20 || stats.PeakMaxRSS != 60<<20 {
+		t.Errorf("unexpected RSS: avg %d peak %d", stats.AvgMaxRSS, stats.PeakMaxRSS)
+	}
+	if stats.AvgVoluntaryContextSwitches != 1.5 || stats.AvgInvoluntaryContextSwitches != 2.5 {
+		t.Errorf("unexpected context switches: %+v", stats)
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	for bytes, want := range map[int64]string{512: "512B", 1536: "1.5KiB", 50 << 20: "50.0MiB"} {
+		if got := formatBytes(bytes); got != want {
+			t.Errorf("formatBytes(%d) = %q, want %q", bytes, got, want)
+		}
+	}
+}
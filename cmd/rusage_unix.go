@@ -0,0 +1,25 @@
+//go:build unix
+
+package cmd
+
+import (
+	"os"
+	"runtime"
+	"syscall"
+)
+
+// fillPlatformResourceUsage adds the rusage fields that are not exposed by os.ProcessState
+func fillPlatformResourceUsage(state *os.ProcessState, usage *ResourceUsage) {
+	rusage, ok := state.SysUsage().(*syscall.Rusage)
+	if !ok || rusage == nil {
+		return
+	}
+
+	// ru_maxrss is reported in bytes on Darwin and in kilobytes everywhere else
+	usage.MaxRSS = int64(rusage.Maxrss)
+	if runtime.GOOS != "darwin" && runtime.GOOS != "ios" {
+		usage.MaxRSS *= 1024
+	}
+	usage.VoluntaryContextSwitches = int64(rusage.Nvcsw)
+	usage.InvoluntaryContextSwitches = int64(rusage.Nivcsw)
+}
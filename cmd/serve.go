@@ -0,0 +1,288 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/descriptions"
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+	"github.com/jfrog/jfrog-cli-manager/internal"
+	"github.com/urfave/cli/v2"
+)
+
+// Paths served by the mirror, following the releases.jfrog.io layout under mirrorReleasesPath
+const (
+	mirrorReleasesPath = "/v2-jf/"
+	mirrorIndexPath    = "/index.json"
+	mirrorChecksumExt  = ".sha256"
+
+	// ChecksumHeader carries the SHA-256 of served binaries, as Artifactory does
+	ChecksumHeader = "X-Checksum-Sha256"
+)
+
+// serveShutdownTimeout bounds how long in-flight downloads may finish after an interrupt
+const serveShutdownTimeout = 10 * time.Second
+
+var Serve = &cli.Command{
+	Name:        "serve",
+	Usage:       descriptions.Serve.Usage,
+	Description: descriptions.Serve.Format(),
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "addr",
+			Usage: "Address to listen on",
+			Value: ":8089",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		addr := c.String("addr")
+		server := &http.Server{
+			Addr:              addr,
+			Handler:           logMirrorRequests(newMirrorHandler()),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+			defer cancel()
+			_ = server.Shutdown(shutdownCtx)
+		}()
+
+		// Listening on all interfaces: show an address other machines can use
+		host := addr
+		if strings.HasPrefix(host, ":") {
+			hostname, err := os.Hostname()
+			if err != nil {
+				hostname = "localhost"
+			}
+			host = hostname + host
+		}
+		fmt.Printf("🌐 Serving %s on http://%s\n", utils.JFCMRoot, host)
+		fmt.Printf("💡 Point other jfcm clients at it with: jfcm settings set %s http://%s\n", utils.SettingMirrorURL, host)
+		fmt.Printf("📋 Version index: http://%s%s\n", host, mirrorIndexPath)
+
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return cli.Exit(fmt.Sprintf("❌ Failed to serve on %s: %v", addr, err), 1)
+		}
+		fmt.Println("👋 Mirror stopped")
+		return nil
+	},
+}
+
+// MirrorIndex lists the builds a mirror serves
+type MirrorIndex struct {
+	Latest   string        `json:"latest,omitempty"`
+	Versions []MirrorBuild `json:"versions"`
+}
+
+// MirrorBuild is one build served by a mirror
+type MirrorBuild struct {
+	Version  string `json:"version"`
+	Platform string `json:"platform"`
+	Path     string `json:"path"`
+	SHA256   string `json:"sha256"`
+	Size     int64  `json:"size"`
+}
+
+// newMirrorHandler serves the installed versions of every platform in the releases.jfrog.io layout,
+// with a .sha256 file next to each binary and a JSON index of all builds. The version store is read
+// on every request, so versions installed while serving are picked up.
+func newMirrorHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(mirrorIndexPath, serveMirrorIndex)
+	mux.HandleFunc(mirrorReleasesPath, serveMirrorRelease)
+	return mux
+}
+
+// serveMirrorIndex writes the JSON index of all builds
+func serveMirrorIndex(w http.ResponseWriter, r *http.Request) {
+	index, err := buildMirrorIndex()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(index)
+}
+
+// buildMirrorIndex lists the installed builds of every platform
+func buildMirrorIndex() (*MirrorIndex, error) {
+	index := &MirrorIndex{Versions: []MirrorBuild{}}
+	var latest *utils.Version
+	for _, platform := range internal.Platforms {
+		versionsDir := utils.PlatformVersionsDir(platform.Name())
+		versions, err := versionDirsIn(versionsDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", versionsDir, err)
+		}
+		for _, version := range versions {
+			digest, size, err := mirrorBinaryDigest(versionsDir, version)
+			if err != nil {
+				// Directories without a binary are leftovers of failed installs
+				continue
+			}
+			index.Versions = append(index.Versions, MirrorBuild{
+				Version:  version,
+				Platform: platform.Name(),
+				Path:     strings.TrimPrefix(platform.URL("", version), "/"),
+				SHA256:   digest,
+				Size:     size,
+			})
+			if parsed, err := utils.ParseVersion(version); err == nil && (latest == nil || parsed.Compare(*latest) > 0) {
+				latest = &parsed
+			}
+		}
+	}
+	if latest != nil {
+		index.Latest = latest.String()
+	}
+	return index, nil
+}
+
+// serveMirrorRelease serves /v2-jf/<version>/jfrog-cli-<release>/<binary>[.sha256] and a listing of
+// the versions at /v2-jf/
+func serveMirrorRelease(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	rest := strings.TrimPrefix(r.URL.Path, mirrorReleasesPath)
+	if rest == "" {
+		serveMirrorListing(w)
+		return
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) != 3 {
+		http.NotFound(w, r)
+		return
+	}
+	version, release, file := parts[0], parts[1], parts[2]
+	// The version becomes a path element; reject "..", backslashes and volume names of any platform
+	if utils.ValidateVersionName(version) != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	platform, err := internal.LookupRelease(strings.TrimPrefix(release, "jfrog-cli-"))
+	if err != nil || !strings.HasPrefix(release, "jfrog-cli-") {
+		http.NotFound(w, r)
+		return
+	}
+	checksum := strings.HasSuffix(file, mirrorChecksumExt)
+	if strings.TrimSuffix(file, mirrorChecksumExt) != platform.BinaryFile() {
+		http.NotFound(w, r)
+		return
+	}
+
+	versionsDir := utils.PlatformVersionsDir(platform.Name())
+	digest, _, err := mirrorBinaryDigest(versionsDir, version)
+	if os.IsNotExist(err) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if checksum {
+		// sha256sum format, so `sha256sum -c` works next to the downloaded binary
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprintf(w, "%s  %s\n", digest, platform.BinaryFile())
+		return
+	}
+
+	binary, err := os.Open(filepath.Join(versionsDir, version, utils.BinaryName))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer binary.Close()
+	info, err := binary.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(ChecksumHeader, digest)
+	w.Header().Set("Content-Type", "application/octet-stream")
+	// ServeContent answers Range requests, so interrupted client downloads resume
+	http.ServeContent(w, r, platform.BinaryFile(), info.ModTime(), binary)
+}
+
+// serveMirrorListing writes an HTML listing of the served versions, like the one of releases.jfrog.io
+func serveMirrorListing(w http.ResponseWriter) {
+	index, err := buildMirrorIndex()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprintln(w, "<html><body><pre>")
+	var versions []string
+	for _, build := range index.Versions {
+		if !slices.Contains(versions, build.Version) {
+			versions = append(versions, build.Version)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return compareVersionNames(versions[i], versions[j]) < 0
+	})
+	for _, version := range versions {
+		name := html.EscapeString(version)
+		fmt.Fprintf(w, "<a href=\"%s/\">%s/</a>\n", name, name)
+	}
+	fmt.Fprintln(w, "</pre></body></html>")
+}
+
+// mirrorBinaryDigest returns the SHA-256 and size of an installed binary, preferring the digest
+// recorded in its metadata over hashing the file again
+func mirrorBinaryDigest(versionsDir, version string) (string, int64, error) {
+	binPath := filepath.Join(versionsDir, version, utils.BinaryName)
+	info, err := os.Stat(binPath)
+	if err != nil {
+		return "", 0, err
+	}
+	if meta, err := utils.LoadVersionMetaIn(versionsDir, version); err == nil && meta.SHA256 != "" && meta.Size == info.Size() {
+		return meta.SHA256, info.Size(), nil
+	}
+	return utils.HashFile(binPath)
+}
+
+// statusRecorder remembers the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// logMirrorRequests prints one line per request
+func logMirrorRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+		fmt.Printf("%s %s %s %s %d\n", time.Now().Format("15:04:05"), r.RemoteAddr, r.Method, r.URL.Path, recorder.status)
+	})
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+	"github.com/jfrog/jfrog-cli-manager/internal"
+)
+
+func TestMirrorServesInstalledVersions(t *testing.T) {
+	useJFCMRoot(t, t.TempDir())
+	host, err := installPlatform("")
+	if err != nil {
+		t.Skip(err)
+	}
+	windows, _ := internal.LookupPlatform("windows", "amd64")
+	builds := map[internal.Platform]string{host: "2.50.0", windows: "2.51.0"}
+	for platform, version := range builds {
+		versionsDir := utils.PlatformVersionsDir(platform.Name())
+		if err := os.MkdirAll(filepath.Join(versionsDir, version), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(versionsDir, version, utils.BinaryName), []byte("jf "+version+" "+platform.Name()), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A failed install leaves a directory without a binary
+	if err := os.MkdirAll(filepath.Join(utils.JFCMVersions, "2.49.0"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(newMirrorHandler())
+	defer server.Close()
+
+	// The download path used by 'jfcm install' works against the mirror
+	dest := filepath.Join(t.TempDir(), "jf")
+	downloader := &internal.Downloader{Client: server.Client(), Attempts: 1}
+	if err := downloader.Download(context.Background(), "jf", windows.URL(server.URL, "2.51.0"), dest); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(dest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "jf 2.51.0 windows-amd64" {
+		t.Errorf("unexpected binary content %q", content)
+	}
+	sum := sha256.Sum256(content)
+	digest := hex.EncodeToString(sum[:])
+
+	resp, body := mirrorGet(t, windows.URL(server.URL, "2.51.0")+".sha256", "")
+	if resp.StatusCode != http.StatusOK || body != digest+"  jf.exe\n" {
+		t.Errorf("unexpected checksum response %d %q", resp.StatusCode, body)
+	}
+	resp, body = mirrorGet(t, windows.URL(server.URL, "2.51.0"), "bytes=3-")
+	if resp.StatusCode != http.StatusPartialContent || body != "2.51.0 windows-amd64" {
+		t.Errorf("expected a partial response, got %d %q", resp.StatusCode, body)
+	}
+	if got := resp.Header.Get(ChecksumHeader); got != digest {
+		t.Errorf("expected %s header %s, got %s", ChecksumHeader, digest, got)
+	}
+
+	for _, url := range []string{
+		host.URL(server.URL, "2.49.0"),
+		host.URL(server.URL, "9.9.9"),
+		server.URL + "/v2-jf/2.51.0/jfrog-cli-windows-amd64/jf",
+		server.URL + "/v2-jf/2.50.0/jfrog-cli-plan9-amd64/jf",
+		server.URL + "/v2-jf/../jfrog-cli-linux-amd64/jf",
+		server.URL + "/v2-jf/..%5C..%5C2.50.0/" + strings.TrimPrefix(host.URL("", "2.50.0"), "/v2-jf/2.50.0/"),
+		server.URL + "/v2-jf/2.50.0%5C..%5C2.50.0/" + strings.TrimPrefix(host.URL("", "2.50.0"), "/v2-jf/2.50.0/"),
+		server.URL + "/v2-jf/C:2.50.0/" + strings.TrimPrefix(host.URL("", "2.50.0"), "/v2-jf/2.50.0/"),
+	} {
+		if resp, _ := mirrorGet(t, url, ""); resp.StatusCode != http.StatusNotFound {
+			t.Errorf("%s: expected 404, got %d", url, resp.StatusCode)
+		}
+	}
+
+	resp, body = mirrorGet(t, server.URL+mirrorIndexPath, "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected index status %d", resp.StatusCode)
+	}
+	var index MirrorIndex
+	if err := json.Unmarshal([]byte(body), &index); err != nil {
+		t.Fatal(err)
+	}
+	if index.Latest != "2.51.0" || len(index.Versions) != 2 {
+		t.Fatalf("unexpected index %+v", index)
+	}
+	for _, build := range index.Versions {
+		if build.Platform == windows.Name() && (build.SHA256 != digest || build.Path != "v2-jf/2.51.0/jfrog-cli-windows-amd64/jf.exe") {
+			t.Errorf("unexpected build %+v", build)
+		}
+	}
+}
+
+// mirrorGet fetches url, optionally with a Range header, and returns the response and its body
+func mirrorGet(t *testing.T, url, byteRange string) (*http.Response, string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if byteRange != "" {
+		req.Header.Set("Range", byteRange)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, string(body)
+}
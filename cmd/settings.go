@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/descriptions"
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+	"github.com/urfave/cli/v2"
+)
+
+var Settings = &cli.Command{
+	Name:        "settings",
+	Usage:       descriptions.Settings.Usage,
+	Description: descriptions.Settings.Format(),
+	Subcommands: []*cli.Command{
+		{
+			Name:      "set",
+			Usage:     "Set a setting value",
+			ArgsUsage: "<key> <value>",
+			Action: withStateLock(func(c *cli.Context) error {
+				if c.Args().Len() != 2 {
+					return cli.Exit("Usage: jfcm settings set <key> <value>", 1)
+				}
+				key, value := c.Args().Get(0), c.Args().Get(1)
+
+				settings, err := utils.LoadSettings()
+				if err != nil {
+					return cli.Exit(err.Error(), 1)
+				}
+				if err := settings.Set(key, value); err != nil {
+					return cli.Exit(err.Error(), 1)
+				}
+				if err := utils.SaveSettings(settings); err != nil {
+					return cli.Exit(err.Error(), 1)
+				}
+
+				fmt.Printf("✅ %s updated\n", key)
+				return nil
+			}),
+		},
+		{
+			Name:      "get",
+			Usage:     "Print the stored value of a setting",
+			ArgsUsage: "<key>",
+			Action: func(c *cli.Context) error {
+				if c.Args().Len() != 1 {
+					return cli.Exit("Usage: jfcm settings get <key>", 1)
+				}
+
+				settings, err := utils.LoadSettings()
+				if err != nil {
+					return cli.Exit(err.Error(), 1)
+				}
+				value, err := settings.Get(c.Args().Get(0))
+				if err != nil {
+					return cli.Exit(err.Error(), 1)
+				}
+
+				fmt.Println(value)
+				return nil
+			},
+		},
+		{
+			Name:      "unset",
+			Usage:     "Reset a setting to its default",
+			ArgsUsage: "<key>",
+			Action: withStateLock(func(c *cli.Context) error {
+				if c.Args().Len() != 1 {
+					return cli.Exit("Usage: jfcm settings unset <key>", 1)
+				}
+				key := c.Args().Get(0)
+
+				settings, err := utils.LoadSettings()
+				if err != nil {
+					return cli.Exit(err.Error(), 1)
+				}
+				if err := settings.Set(key, ""); err != nil {
+					return cli.Exit(err.Error(), 1)
+				}
+				if err := utils.SaveSettings(settings); err != nil {
+					return cli.Exit(err.Error(), 1)
+				}
+
+				fmt.Printf("✅ %s reset to default\n", key)
+				return nil
+			}),
+		},
+		{
+			Name:  "list",
+			Usage: "List all settings with their effective values",
+			Action: func(c *cli.Context) error {
+				fmt.Printf("⚙️  Settings (%s)\n", utils.JFCMSettings)
+				for _, key := range utils.SettingKeys() {
+					value, source, err := utils.EffectiveSetting(key)
+					if err != nil {
+						return cli.Exit(err.Error(), 1)
+					}
+					if utils.IsSecretSetting(key) {
+						value = maskSecret(value)
+					}
+					if value == "" {
+						value = "(not set)"
+					}
+					fmt.Printf("  %-16s %s (%s)\n", key, value, source)
+				}
+				return nil
+			},
+		},
+	},
+}
+
+// maskSecret hides all but the last four characters of a secret value
+func maskSecret(value string) string {
+	if len(value) <= 4 {
+		return strings.Repeat("*", len(value))
+	}
+	return strings.Repeat("*", len(value)-4) + value[len(value)-4:]
+}
@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// SignificanceLevel is the p-value below which a timing difference is reported as significant
+const SignificanceLevel = 0.05
+
+// DurationStats summarizes a sample of execution durations
+type DurationStats struct {
+	Mean     time.Duration
+	Median   time.Duration
+	P90      time.Duration
+	P95      time.Duration
+	StdDev   time.Duration
+	CILow    time.Duration
+	CIHigh   time.Duration
+	Outliers int
+}
+
+// SignificanceResult is the outcome of a Welch's t-test between two samples
+type SignificanceResult struct {
+	Difference  time.Duration
+	PValue      float64
+	Significant bool
+	Valid       bool
+}
+
+// computeDurationStats computes the descriptive statistics of a sample. The confidence interval
+// is the 95% interval of the mean; outliers are values outside the 1.5 IQR Tukey fences.
+func computeDurationStats(durations []time.Duration) DurationStats {
+	var stats DurationStats
+	n := len(durations)
+	if n == 0 {
+		return stats
+	}
+
+	values := durationsToMillis(durations)
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	mean, variance := meanAndVariance(values)
+	stddev := math.Sqrt(variance)
+
+	stats.Mean = millisToDuration(mean)
+	stats.Median = millisToDuration(percentile(sorted, 50))
+	stats.P90 = millisToDuration(percentile(sorted, 90))
+	stats.P95 = millisToDuration(percentile(sorted, 95))
+	stats.StdDev = millisToDuration(stddev)
+
+	stats.CILow, stats.CIHigh = stats.Mean, stats.Mean
+	if n > 1 {
+		margin := studentTCritical(float64(n-1), SignificanceLevel) * stddev / math.Sqrt(float64(n))
+		stats.CILow = millisToDuration(math.Max(0, mean-margin))
+		stats.CIHigh = millisToDuration(mean + margin)
+	}
+
+	q1, q3 := percentile(sorted, 25), percentile(sorted, 75)
+	iqr := q3 - q1
+	for _, v := range sorted {
+		if v < q1-1.5*iqr || v > q3+1.5*iqr {
+			stats.Outliers++
+		}
+	}
+
+	return stats
+}
+
+// welchTTest tests whether the means of two samples differ, without assuming equal variances.
+// Difference is mean(b) - mean(a).
+func welchTTest(a, b []time.Duration) SignificanceResult {
+	var result SignificanceResult
+	if len(a) < 2 || len(b) < 2 {
+		return result
+	}
+
+	meanA, varA := meanAndVariance(durationsToMillis(a))
+	meanB, varB := meanAndVariance(durationsToMillis(b))
+	result.Difference = millisToDuration(meanB - meanA)
+	result.Valid = true
+
+	seA, seB := varA/float64(len(a)), varB/float64(len(b))
+	if seA+seB == 0 {
+		// Identical constant samples cannot be told apart; different constants always can
+		result.PValue = 1
+		if meanA != meanB {
+			result.PValue = 0
+		}
+		result.Significant = result.PValue < SignificanceLevel
+		return result
+	}
+
+	t := (meanB - meanA) / math.Sqrt(seA+seB)
+	df := (seA + seB) * (seA + seB) /
+		(seA*seA/float64(len(a)-1) + seB*seB/float64(len(b)-1))
+
+	result.PValue = studentTTwoTailedP(t, df)
+	result.Significant = result.PValue < SignificanceLevel
+	return result
+}
+
+// percentile returns the p-th percentile of sorted values using linear interpolation
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+// meanAndVariance returns the mean and the unbiased sample variance
+func meanAndVariance(values []float64) (float64, float64) {
+	n := float64(len(values))
+	if n == 0 {
+		return 0, 0
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / n
+	if n < 2 {
+		return mean, 0
+	}
+	squares := 0.0
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	return mean, squares / (n - 1)
+}
+
+// studentTTwoTailedP returns the two-tailed p-value of t under Student's t distribution
+func studentTTwoTailedP(t, df float64) float64 {
+	return regularizedIncompleteBeta(df/(df+t*t), df/2, 0.5)
+}
+
+// studentTCritical returns the two-tailed critical value of Student's t distribution for alpha
+func studentTCritical(df, alpha float64) float64 {
+	lo, hi := 0.0, 1000.0
+	for i := 0; i < 100; i++ {
+		mid := (lo + hi) / 2
+		if studentTTwoTailedP(mid, df) > alpha {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// regularizedIncompleteBeta computes I_x(a, b) with the continued fraction of Numerical Recipes
+func regularizedIncompleteBeta(x, a, b float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+	lgA, _ := math.Lgamma(a)
+	lgB, _ := math.Lgamma(b)
+	lgAB, _ := math.Lgamma(a + b)
+	front := math.Exp(lgAB - lgA - lgB + a*math.Log(x) + b*math.Log(1-x))
+
+	// The continued fraction converges quickly only below the mean of the distribution
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(x, a, b) / a
+	}
+	return 1 - front*betaContinuedFraction(1-x, b, a)/b
+}
+
+// betaContinuedFraction evaluates the continued fraction of the incomplete beta function
+func betaContinuedFraction(x, a, b float64) float64 {
+	const (
+		maxIterations = 200
+		epsilon       = 3e-14
+		tiny          = 1e-300
+	)
+
+	c, d := 1.0, 1-(a+b)*x/(a+1)
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+
+	for m := 1; m <= maxIterations; m++ {
+		fm := float64(m)
+		numerator := fm * (b - fm) * x / ((a + 2*fm - 1) * (a + 2*fm))
+		d = 1 + numerator*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + numerator/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+
+		numerator = -(a + fm) * (a + b + fm) * x / ((a + 2*fm) * (a + 2*fm + 1))
+		d = 1 + numerator*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + numerator/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+	return h
+}
+
+// durationsToMillis converts durations to fractional milliseconds
+func durationsToMillis(durations []time.Duration) []float64 {
+	values := make([]float64, len(durations))
+	for i, d := range durations {
+		values[i] = float64(d.Nanoseconds()) / 1e6
+	}
+	return values
+}
+
+// millisToDuration converts fractional milliseconds back to a duration
+func millisToDuration(ms float64) time.Duration {
+	return time.Duration(ms * 1e6)
+}
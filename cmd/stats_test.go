@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func millis(values ...float64) []time.Duration {
+	durations := make([]time.Duration, len(values))
+	for i, v := range values {
+		durations[i] = millisToDuration(v)
+	}
+	return durations
+}
+
+func TestComputeDurationStats(t *testing.T) {
+	stats := computeDurationStats(millis(12, 10, 11, 10, 100, 11, 12, 13, 11, 10))
+
+	if stats.Median != 11*time.Millisecond {
+		t.Errorf("Median = %v, want 11ms", stats.Median)
+	}
+	if stats.Mean != 20*time.Millisecond {
+		t.Errorf("Mean = %v, want 20ms", stats.Mean)
+	}
+	if stats.Outliers != 1 {
+		t.Errorf("Outliers = %d, want 1", stats.Outliers)
+	}
+	if stats.P95 <= stats.P90 || stats.P90 <= stats.Median {
+		t.Errorf("expected median < p90 < p95, got %v %v %v", stats.Median, stats.P90, stats.P95)
+	}
+	if stats.CILow >= stats.Mean || stats.CIHigh <= stats.Mean {
+		t.Errorf("expected the confidence interval to contain the mean, got %v..%v", stats.CILow, stats.CIHigh)
+	}
+}
+
+func TestStudentTDistribution(t *testing.T) {
+	// Reference values from standard t tables
+	if p := studentTTwoTailedP(2.0, 10); math.Abs(p-0.0734) > 0.0005 {
+		t.Errorf("p(t=2, df=10) = %.4f, want 0.0734", p)
+	}
+	if c := studentTCritical(9, 0.05); math.Abs(c-2.262) > 0.001 {
+		t.Errorf("critical t(df=9) = %.3f, want 2.262", c)
+	}
+}
+
+func TestWelchTTest(t *testing.T) {
+	slow := welchTTest(millis(10, 11, 12, 13, 14), millis(20, 21, 22, 23, 24))
+	if !slow.Valid || !slow.Significant || slow.Difference != 10*time.Millisecond {
+		t.Errorf("expected a significant 10ms difference, got %+v", slow)
+	}
+
+	noise := welchTTest(millis(10, 14, 11, 13, 12), millis(12, 10, 14, 11, 13.5))
+	if !noise.Valid || noise.Significant {
+		t.Errorf("expected overlapping samples not to differ significantly, got %+v", noise)
+	}
+
+	if single := welchTTest(millis(10), millis(20)); single.Valid {
+		t.Errorf("expected a single iteration to be untestable, got %+v", single)
+	}
+}
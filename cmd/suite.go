@@ -0,0 +1,446 @@
+package cmd
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Suite defaults used when neither the suite nor the command sets a value
+const (
+	DefaultSuiteIterations = 5
+	DefaultSuiteTimeout    = 30
+)
+
+// BenchmarkSuite is a set of jf commands benchmarked together, loaded from YAML or JSON
+type BenchmarkSuite struct {
+	Name       string            `yaml:"name"`
+	Iterations *int              `yaml:"iterations"`
+	Warmup     *int              `yaml:"warmup"`
+	Timeout    *int              `yaml:"timeout"` // seconds
+	Mode       string            `yaml:"mode"`
+	Env        map[string]string `yaml:"env"`
+	Setup      []string          `yaml:"setup"`
+	Teardown   []string          `yaml:"teardown"`
+	Commands   []SuiteCommand    `yaml:"commands"`
+}
+
+// SuiteCommand is one named jf command of a suite. Unset values are inherited from the suite.
+type SuiteCommand struct {
+	Name       string            `yaml:"name"`
+	Args       []string          `yaml:"args"`
+	Iterations *int              `yaml:"iterations"`
+	Warmup     *int              `yaml:"warmup"`
+	Timeout    *int              `yaml:"timeout"` // seconds
+	Env        map[string]string `yaml:"env"`
+	Setup      []string          `yaml:"setup"`
+	Teardown   []string          `yaml:"teardown"`
+}
+
+// SuiteCommandResult holds the benchmark results of one suite command
+type SuiteCommandResult struct {
+	Name    string
+	Args    []string
+	Results []BenchmarkResult
+	Error   string
+}
+
+var benchmarkRunCommand = &cli.Command{
+	Name:      "run",
+	Usage:     "Run a benchmark suite defined in a YAML or JSON file",
+	ArgsUsage: "<suite-file>",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "versions",
+			Usage: "Comma-separated versions or aliases to benchmark",
+		},
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "Output format: table, json, csv",
+			Value: "table",
+		},
+		&cli.StringFlag{
+			Name:  "mode",
+			Usage: "Execution mode overriding the suite: sequential, interleaved or parallel",
+		},
+		&cli.StringFlag{
+			Name:  "isolate-home",
+			Usage: "Run jf against a temporary copy of the JFrog CLI home (JFROG_CLI_HOME_DIR): per-version or per-run",
+		},
+		&cli.BoolFlag{
+			Name:  "no-color",
+			Usage: "Disable colored output",
+		},
+		&cli.BoolFlag{
+			Name:  "detailed",
+			Usage: "Show detailed execution logs",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		usage := "Usage: jfcm benchmark run <suite-file> --versions <version1,version2,...>"
+		if c.Args().Len() < 1 {
+			return cli.Exit(usage, 1)
+		}
+		// Flags may follow the suite file, as in the usage line
+		if err := parseTrailingFlags(c, c.Args().Tail()); err != nil {
+			return cli.Exit(fmt.Sprintf("❌ %v\n%s", err, usage), 1)
+		}
+		if c.String("versions") == "" {
+			return cli.Exit("❌ --versions is required\n"+usage, 1)
+		}
+
+		suite, err := loadBenchmarkSuite(c.Args().First())
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		base := BenchmarkConfig{
+			Format:      c.String("format"),
+			NoColor:     c.Bool("no-color"),
+			Detailed:    c.Bool("detailed"),
+			IsolateHome: c.String("isolate-home"),
+			Mode:        suite.Mode,
+		}
+		if c.IsSet("mode") {
+			base.Mode = c.String("mode")
+		}
+		if base.Mode == "" {
+			base.Mode = BenchmarkModeSequential
+		}
+		for i := range suite.Commands {
+			if err := validateBenchmarkConfig(suiteCommandConfig(base, suite, &suite.Commands[i])); err != nil {
+				return cli.Exit(fmt.Sprintf("%v (command '%s')", err, suite.Commands[i].Name), 1)
+			}
+		}
+
+		versions, err := validateVersions(strings.Split(c.String("versions"), ","))
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		results, err := runBenchmarkSuite(suite, versions, base)
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		displaySuiteResults(suite, versions, results, base)
+
+		if failed := countFailedSuiteCommands(results); failed > 0 {
+			return cli.Exit(fmt.Sprintf("❌ %d of %d suite command(s) failed", failed, len(results)), 1)
+		}
+		return nil
+	},
+}
+
+// parseTrailingFlags parses the flags found after the positional arguments, which the flag parser
+// stops at, with the command's own flag set and applies them to c
+func parseTrailingFlags(c *cli.Context, args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	set := flag.NewFlagSet(c.Command.Name, flag.ContinueOnError)
+	set.SetOutput(io.Discard)
+	for _, f := range c.Command.Flags {
+		if err := f.Apply(set); err != nil {
+			return err
+		}
+	}
+	if err := set.Parse(args); err != nil {
+		return err
+	}
+	if set.NArg() > 0 {
+		return fmt.Errorf("unexpected argument '%s'", set.Arg(0))
+	}
+
+	var err error
+	set.Visit(func(f *flag.Flag) {
+		if err == nil {
+			err = c.Set(f.Name, f.Value.String())
+		}
+	})
+	return err
+}
+
+// loadBenchmarkSuite reads and validates a suite file. JSON is accepted as it is a subset of YAML.
+func loadBenchmarkSuite(path string) (*BenchmarkSuite, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("❌ failed to read suite: %w", err)
+	}
+
+	var suite BenchmarkSuite
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&suite); err != nil {
+		return nil, fmt.Errorf("❌ failed to parse suite %s: %w", path, err)
+	}
+
+	if err := validateBenchmarkSuite(&suite); err != nil {
+		return nil, fmt.Errorf("❌ invalid suite %s: %w", path, err)
+	}
+	return &suite, nil
+}
+
+// validateBenchmarkSuite checks the suite has uniquely named commands with arguments
+func validateBenchmarkSuite(suite *BenchmarkSuite) error {
+	if len(suite.Commands) == 0 {
+		return fmt.Errorf("no commands defined")
+	}
+
+	seen := make(map[string]bool)
+	for i, command := range suite.Commands {
+		if command.Name == "" {
+			return fmt.Errorf("command #%d has no name", i+1)
+		}
+		if seen[command.Name] {
+			return fmt.Errorf("duplicate command name '%s'", command.Name)
+		}
+		seen[command.Name] = true
+		if len(command.Args) == 0 {
+			return fmt.Errorf("command '%s' has no args", command.Name)
+		}
+	}
+
+	if suite.Timeout != nil && *suite.Timeout < 1 {
+		return fmt.Errorf("timeout must be at least 1 second")
+	}
+	for _, command := range suite.Commands {
+		if command.Timeout != nil && *command.Timeout < 1 {
+			return fmt.Errorf("command '%s': timeout must be at least 1 second", command.Name)
+		}
+	}
+	return nil
+}
+
+// suiteCommandConfig resolves the benchmark configuration of a command: command values win over
+// suite values, which win over the defaults
+func suiteCommandConfig(base BenchmarkConfig, suite *BenchmarkSuite, command *SuiteCommand) BenchmarkConfig {
+	config := base
+	config.Iterations = firstSet(DefaultSuiteIterations, command.Iterations, suite.Iterations)
+	config.Warmup = firstSet(0, command.Warmup, suite.Warmup)
+	config.Timeout = time.Duration(firstSet(DefaultSuiteTimeout, command.Timeout, suite.Timeout)) * time.Second
+	config.Env = mergeSuiteEnv(suite.Env, command.Env)
+	return config
+}
+
+// firstSet returns the first non-nil value, or def
+func firstSet(def int, values ...*int) int {
+	for _, value := range values {
+		if value != nil {
+			return *value
+		}
+	}
+	return def
+}
+
+// mergeSuiteEnv merges suite and command variables into sorted KEY=VALUE pairs, command values winning
+func mergeSuiteEnv(suiteEnv, commandEnv map[string]string) []string {
+	merged := make(map[string]string, len(suiteEnv)+len(commandEnv))
+	for key, value := range suiteEnv {
+		merged[key] = value
+	}
+	for key, value := range commandEnv {
+		merged[key] = value
+	}
+
+	env := make([]string, 0, len(merged))
+	for key, value := range merged {
+		env = append(env, key+"="+value)
+	}
+	sort.Strings(env)
+	return env
+}
+
+// runBenchmarkSuite runs every command of the suite against all versions. The suite setup must
+// succeed for anything to run; a failing command setup skips that command only. Teardowns run
+// whenever the matching setup succeeded.
+func runBenchmarkSuite(suite *BenchmarkSuite, versions []string, base BenchmarkConfig) ([]SuiteCommandResult, error) {
+	suiteEnv := mergeSuiteEnv(suite.Env, nil)
+	if err := runSuiteHooks("setup", suite.Setup, suiteEnv); err != nil {
+		return nil, fmt.Errorf("❌ suite setup failed: %w", err)
+	}
+	defer func() {
+		if err := runSuiteHooks("teardown", suite.Teardown, suiteEnv); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: suite teardown failed: %v\n", err)
+		}
+	}()
+
+	var results []SuiteCommandResult
+	for i := range suite.Commands {
+		command := &suite.Commands[i]
+		config := suiteCommandConfig(base, suite, command)
+		result := SuiteCommandResult{Name: command.Name, Args: command.Args}
+
+		if base.Format == "table" {
+			fmt.Printf("▶️  [%d/%d] %s\n", i+1, len(suite.Commands), command.Name)
+		}
+
+		if err := runSuiteHooks("setup", command.Setup, config.Env); err != nil {
+			result.Error = fmt.Sprintf("setup failed: %v", err)
+			fmt.Fprintf(os.Stderr, "⚠️  Skipping '%s': %s\n\n", command.Name, result.Error)
+			results = append(results, result)
+			continue
+		}
+
+		benchmarks, err := runBenchmarks(versions, command.Args, config)
+		result.Results = benchmarks
+		if err != nil {
+			result.Error = err.Error()
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: '%s': %v\n\n", command.Name, err)
+		}
+
+		if err := runSuiteHooks("teardown", command.Teardown, config.Env); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: teardown of '%s' failed: %v\n", command.Name, err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// runSuiteHooks runs setup or teardown shell commands in order, stopping at the first failure
+func runSuiteHooks(kind string, hooks []string, env []string) error {
+	for _, hook := range hooks {
+		var cmd *exec.Cmd
+		if runtime.GOOS == "windows" {
+			cmd = exec.Command("cmd", "/C", hook)
+		} else {
+			cmd = exec.Command("sh", "-c", hook)
+		}
+		cmd.Env = append(os.Environ(), env...)
+
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("%s '%s': %w\n%s", kind, hook, err, strings.TrimSpace(string(output)))
+		}
+	}
+	return nil
+}
+
+// countFailedSuiteCommands returns the number of commands that could not be benchmarked
+func countFailedSuiteCommands(results []SuiteCommandResult) int {
+	failed := 0
+	for _, result := range results {
+		if result.Error != "" {
+			failed++
+		}
+	}
+	return failed
+}
+
+// displaySuiteResults prints the combined report of a suite in the configured format
+func displaySuiteResults(suite *BenchmarkSuite, versions []string, results []SuiteCommandResult, config BenchmarkConfig) {
+	switch config.Format {
+	case "json":
+		displaySuiteJSON(suite, results)
+	case "csv":
+		displaySuiteCSV(results)
+	default:
+		for _, result := range results {
+			if len(result.Results) == 0 {
+				continue
+			}
+			fmt.Printf("\n━━━ %s: jf %s ━━━\n\n", result.Name, strings.Join(result.Args, " "))
+			displayEnhancedBenchmarkResults(result.Results, config.NoColor, config.Detailed)
+		}
+		displaySuiteSummary(suite, versions, results)
+	}
+}
+
+// displaySuiteSummary prints the median time of every command and version, marking the fastest
+func displaySuiteSummary(suite *BenchmarkSuite, versions []string, results []SuiteCommandResult) {
+	title := "SUITE SUMMARY"
+	if suite.Name != "" {
+		title += ": " + suite.Name
+	}
+	fmt.Printf("\n📋 %s (median per command, ★ = fastest)\n", title)
+
+	// Auto formatting would mangle version numbers in the header
+	table := tablewriter.NewTable(os.Stdout, tablewriter.WithHeaderAutoFormat(tw.Off))
+	header := []any{"COMMAND"}
+	for _, version := range versions {
+		header = append(header, version)
+	}
+	table.Header(header...)
+
+	for _, result := range results {
+		row := []any{result.Name}
+		var fastest time.Duration
+		for _, benchmark := range result.Results {
+			if benchmark.Stats.Median > 0 && (fastest == 0 || benchmark.Stats.Median < fastest) {
+				fastest = benchmark.Stats.Median
+			}
+		}
+		for _, version := range versions {
+			cell := "-"
+			for _, benchmark := range result.Results {
+				if benchmark.Version != version || benchmark.Stats.Median == 0 {
+					continue
+				}
+				cell = formatDuration(benchmark.Stats.Median)
+				if len(result.Results) > 1 && benchmark.Stats.Median == fastest {
+					cell += " ★"
+				}
+			}
+			if result.Error != "" && cell == "-" {
+				cell = "failed"
+			}
+			row = append(row, cell)
+		}
+		table.Append(row...)
+	}
+	table.Render()
+}
+
+// suiteJSONCommand is the JSON representation of one suite command
+type suiteJSONCommand struct {
+	Name    string   `json:"name"`
+	Command []string `json:"command"`
+	Error   string   `json:"error,omitempty"`
+	benchmarkJSONReport
+}
+
+func displaySuiteJSON(suite *BenchmarkSuite, results []SuiteCommandResult) {
+	report := struct {
+		Suite    string             `json:"suite,omitempty"`
+		Commands []suiteJSONCommand `json:"commands"`
+	}{Suite: suite.Name}
+
+	for _, result := range results {
+		report.Commands = append(report.Commands, suiteJSONCommand{
+			Name:                result.Name,
+			Command:             result.Args,
+			Error:               result.Error,
+			benchmarkJSONReport: newBenchmarkJSONReport(result.Results),
+		})
+	}
+	printJSON(report)
+}
+
+func displaySuiteCSV(results []SuiteCommandResult) {
+	fmt.Println("command," + benchmarkCSVHeader)
+	for _, result := range results {
+		for _, benchmark := range result.Results {
+			fmt.Println(csvField(result.Name) + "," + benchmarkCSVRow(benchmark))
+		}
+	}
+}
+
+// csvField quotes a CSV field when it contains a separator, quote or newline
+func csvField(value string) string {
+	if strings.ContainsAny(value, ",\"\n") {
+		return `"` + strings.ReplaceAll(value, `"`, `""`) + `"`
+	}
+	return value
+}
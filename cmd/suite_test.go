@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+	"github.com/urfave/cli/v2"
+)
+
+func writeSuiteFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write suite: %v", err)
+	}
+	return path
+}
+
+func TestLoadBenchmarkSuiteYAML(t *testing.T) {
+	path := writeSuiteFile(t, "suite.yaml", `
+name: nightly
+iterations: 10
+timeout: 60
+env:
+  JFROG_CLI_LOG_LEVEL: ERROR
+  CI: "true"
+commands:
+  - name: ping
+    args: [rt, ping]
+  - name: search
+    args: [rt, search, "libs/*.jar"]
+    iterations: 3
+    warmup: 0
+    timeout: 120
+    env:
+      CI: "false"
+`)
+	suite, err := loadBenchmarkSuite(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if suite.Name != "nightly" || len(suite.Commands) != 2 {
+		t.Fatalf("unexpected suite: %+v", suite)
+	}
+
+	base := BenchmarkConfig{Format: "table", Mode: BenchmarkModeSequential}
+	ping := suiteCommandConfig(base, suite, &suite.Commands[0])
+	if ping.Iterations != 10 || ping.Warmup != 0 || ping.Timeout != 60*time.Second {
+		t.Errorf("ping should inherit suite values, got %+v", ping)
+	}
+	if !reflect.DeepEqual(ping.Env, []string{"CI=true", "JFROG_CLI_LOG_LEVEL=ERROR"}) {
+		t.Errorf("unexpected ping env: %v", ping.Env)
+	}
+
+	search := suiteCommandConfig(base, suite, &suite.Commands[1])
+	if search.Iterations != 3 || search.Timeout != 120*time.Second {
+		t.Errorf("search should override suite values, got %+v", search)
+	}
+	if !reflect.DeepEqual(search.Env, []string{"CI=false", "JFROG_CLI_LOG_LEVEL=ERROR"}) {
+		t.Errorf("command env should win over suite env, got %v", search.Env)
+	}
+}
+
+func TestLoadBenchmarkSuiteJSON(t *testing.T) {
+	path := writeSuiteFile(t, "suite.json", `{
+  "commands": [{"name": "version", "args": ["--version"], "warmup": 2}],
+  "setup": ["true"]
+}`)
+	suite, err := loadBenchmarkSuite(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	config := suiteCommandConfig(BenchmarkConfig{}, suite, &suite.Commands[0])
+	if config.Iterations != DefaultSuiteIterations || config.Warmup != 2 || config.Timeout != DefaultSuiteTimeout*time.Second {
+		t.Errorf("unexpected defaults: %+v", config)
+	}
+}
+
+func TestLoadBenchmarkSuiteInvalid(t *testing.T) {
+	cases := map[string]string{
+		"no commands":    `name: empty`,
+		"no name":        `commands: [{args: [--version]}]`,
+		"no args":        `commands: [{name: version}]`,
+		"duplicate name": `commands: [{name: a, args: [x]}, {name: a, args: [y]}]`,
+		"bad timeout":    `commands: [{name: a, args: [x], timeout: 0}]`,
+		"unknown field":  `commands: [{name: a, args: [x], iterashuns: 3}]`,
+	}
+	for name, content := range cases {
+		if _, err := loadBenchmarkSuite(writeSuiteFile(t, "suite.yaml", content)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestBenchmarkRunFlagsAfterSuiteFile(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses shell scripts as fake jf binaries")
+	}
+	useJFCMRoot(t, t.TempDir())
+	for _, version := range []string{"2.55.0", "2.60.0"} {
+		writeFakeVersion(t, version)
+	}
+	suite := writeSuiteFile(t, "suite.yaml", "commands: [{name: version, args: [--version], iterations: 1}]")
+
+	app := &cli.App{
+		Commands:       []*cli.Command{Benchmark},
+		ExitErrHandler: func(*cli.Context, error) {},
+	}
+	run := func(args ...string) error {
+		return app.Run(append([]string{"jfcm", "benchmark", "run"}, args...))
+	}
+
+	// The order given in the usage line
+	if err := run(suite, "--versions", "2.55.0,2.60.0"); err != nil {
+		t.Fatalf("expected flags after the suite file to be parsed, got %v", err)
+	}
+	if err := run("--versions", "2.55.0", suite); err != nil {
+		t.Fatalf("expected flags before the suite file to be parsed, got %v", err)
+	}
+
+	cases := map[string]struct {
+		args    []string
+		message string
+	}{
+		"trailing flag value is applied": {[]string{suite, "--versions=2.55.0", "--mode", "bogus"}, "unsupported mode 'bogus'"},
+		"unknown trailing flag":          {[]string{suite, "--versions", "2.55.0", "--bogus"}, "flag provided but not defined"},
+		"extra argument":                 {[]string{suite, "--versions", "2.55.0", "other.yaml"}, "unexpected argument 'other.yaml'"},
+		"missing versions":               {[]string{suite, "--detailed"}, "--versions is required"},
+	}
+	for name, tc := range cases {
+		if err := run(tc.args...); err == nil || !strings.Contains(err.Error(), tc.message) {
+			t.Errorf("%s: expected %q error, got %v", name, tc.message, err)
+		}
+	}
+}
+
+// writeFakeVersion installs a jf script printing its version
+func writeFakeVersion(t *testing.T, version string) {
+	t.Helper()
+	dir := filepath.Join(utils.JFCMVersions, version)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	script := "#!/bin/sh\necho \"jf version " + version + "\"\n"
+	if err := os.WriteFile(filepath.Join(dir, utils.BinaryName), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCSVField(t *testing.T) {
+	if got := csvField("plain"); got != "plain" {
+		t.Errorf("unexpected %q", got)
+	}
+	if got := csvField(`a,"b"`); got != `"a,""b"""` {
+		t.Errorf("unexpected %q", got)
+	}
+}
@@ -12,7 +12,7 @@ var Unblock = &cli.Command{
 	Usage:       "Unblock a previously blocked version of jf cli",
 	ArgsUsage:   "<version>",
 	Description: `Unblock a specific version of jf-cli that was previously blocked.`,
-	Action: func(c *cli.Context) error {
+	Action: withStateLock(func(c *cli.Context) error {
 		if c.Args().Len() != 1 {
 			return cli.Exit("Please provide a specific version to unblock", 1)
 		}
@@ -31,5 +31,5 @@ var Unblock = &cli.Command{
 
 		fmt.Printf("✅ Successfully unblocked version %s\n", version)
 		return nil
-	},
+	}),
 }
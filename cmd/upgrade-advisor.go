@@ -0,0 +1,489 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/descriptions"
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/sync/errgroup"
+)
+
+// helpProbeConcurrency bounds the number of `jf <command> --help` processes run at once
+const helpProbeConcurrency = 8
+
+var UpgradeAdvisor = &cli.Command{
+	Name:        "upgrade-advisor",
+	Usage:       descriptions.UpgradeAdvisor.Usage,
+	ArgsUsage:   "[target version or alias] (default: latest)",
+	Description: descriptions.UpgradeAdvisor.Format(),
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "from",
+			Usage: "Version to upgrade from (default: the active version, then .jfrog-version)",
+		},
+		&cli.BoolFlag{
+			Name:    "offline",
+			Usage:   "Use only cached release data, without contacting the GitHub API",
+			EnvVars: []string{"JFCM_OFFLINE"},
+		},
+		&cli.IntFlag{
+			Name:  "timeout",
+			Usage: "Timeout in seconds for fetching release notes and probing commands",
+			Value: 60,
+		},
+		&cli.BoolFlag{
+			Name:  "no-color",
+			Usage: "Disable colored output",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		if c.Args().Len() > 1 {
+			return cli.Exit("Usage: jfcm upgrade-advisor [target version or alias]", 1)
+		}
+
+		current, source, err := resolveUpgradeSource(c.String("from"))
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("❌ %v", err), 1)
+		}
+		target, err := resolveUpgradeTarget(c.Args().First())
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("❌ %v", err), 1)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(c.Int("timeout"))*time.Second)
+		defer cancel()
+
+		return runUpgradeAdvisor(ctx, current, source, target, c.Bool("offline"), c.Bool("no-color"))
+	},
+}
+
+// UsageImpact describes how a command from the usage history is affected by an upgrade
+type UsageImpact struct {
+	Command string
+	Uses    int
+	Reasons []string
+}
+
+// resolveUpgradeSource returns the version to upgrade from and where it was taken from
+func resolveUpgradeSource(from string) (string, string, error) {
+	if from != "" {
+		version, err := utils.ResolveVersionOrAlias(from)
+		if err != nil {
+			return "", "", err
+		}
+		return version, "--from", nil
+	}
+
+	if active, err := utils.GetActiveVersion(); err == nil && active != "" {
+		return active, "active version", nil
+	}
+
+	projectVersion, err := utils.ReadProjectVersion()
+	if err != nil || projectVersion == "" {
+		return "", "", fmt.Errorf("no active version and no %s file found; use --from <version>", utils.ProjectFile)
+	}
+	if !utils.IsVersionConstraint(projectVersion) {
+		return projectVersion, utils.ProjectFile, nil
+	}
+
+	installed, err := utils.GetInstalledVersions()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get installed versions: %w", err)
+	}
+	version, err := utils.FindMatchingVersion(projectVersion, installed)
+	if err != nil {
+		return "", "", fmt.Errorf("no installed version satisfies %s (%s); use --from <version>", projectVersion, utils.ProjectFile)
+	}
+	return version, utils.ProjectFile, nil
+}
+
+// resolveUpgradeTarget resolves the target argument, defaulting to the latest release
+func resolveUpgradeTarget(target string) (string, error) {
+	if target == "" || strings.ToLower(target) == "latest" {
+		latest, err := utils.GetLatestVersionWithFallback()
+		if err != nil {
+			return "", fmt.Errorf("failed to get latest version: %w", err)
+		}
+		return latest, nil
+	}
+	return utils.ResolveVersionOrAlias(target)
+}
+
+// runUpgradeAdvisor prints the upgrade report for moving from current to target
+func runUpgradeAdvisor(ctx context.Context, current, source, target string, offline, noColor bool) error {
+	colors := NewColorScheme(noColor)
+
+	fmt.Printf("🧭 Upgrade advisor: %s → %s (from %s)\n\n", colors.Blue.Sprint(current), colors.Blue.Sprint(target), source)
+
+	if currentVersion, err := utils.ParseVersion(current); err == nil {
+		if targetVersion, err := utils.ParseVersion(target); err == nil && targetVersion.Compare(currentVersion) <= 0 {
+			fmt.Printf("%s\n\n", colors.Yellow.Sprintf("⚠️  %s is not newer than %s; this is a downgrade or no-op", target, current))
+		}
+	}
+
+	// Policy checks
+	fmt.Printf("%s\n", colors.Magenta.Sprint("## Policy"))
+	if blocked, err := utils.IsVersionBlocked(target); err != nil {
+		fmt.Printf("  ⚠️  Could not read the block list: %v\n", err)
+	} else if blocked {
+		fmt.Printf("  %s\n", colors.Red.Sprintf("❌ %s is blocked (jfcm unblock %s to allow it)", target, target))
+	} else {
+		fmt.Printf("  %s\n", colors.Green.Sprintf("✅ %s is not blocked", target))
+	}
+
+	if projectVersion, err := utils.ReadProjectVersion(); err != nil || projectVersion == "" {
+		fmt.Printf("  ℹ️  No %s file in this project\n", utils.ProjectFile)
+	} else if err := utils.ValidateVersionAgainstConstraint(target, projectVersion); err != nil {
+		fmt.Printf("  %s\n", colors.Red.Sprintf("❌ %s does not satisfy %s (%s)", target, projectVersion, utils.ProjectFile))
+	} else {
+		fmt.Printf("  %s\n", colors.Green.Sprintf("✅ %s satisfies %s (%s)", target, projectVersion, utils.ProjectFile))
+	}
+
+	// Changelog summary
+	fmt.Printf("\n%s\n", colors.Magenta.Sprint("## Changelog"))
+	notes, err := FetchReleaseNotes(ctx, NewGitHubClient(offline), DefaultChangelogOwner, DefaultChangelogRepo, "v"+strings.TrimPrefix(current, "v"), "v"+strings.TrimPrefix(target, "v"))
+	if err != nil {
+		fmt.Printf("  ⚠️  Release notes unavailable: %v\n", err)
+	} else {
+		for i := range notes {
+			notes[i].Body = FilterReleaseNotes(notes[i].Body)
+		}
+		displayChangelogSummary(notes, colors)
+	}
+
+	// Command surface
+	fmt.Printf("\n%s\n", colors.Magenta.Sprint("## Command surface"))
+	var currentSurface, removed []string
+	missing := missingVersions(current, target)
+	if len(missing) > 0 {
+		fmt.Printf("  ℹ️  Install %s to compare the available commands\n", strings.Join(missing, " and "))
+	} else {
+		var err error
+		currentSurface, err = commandSurface(ctx, current)
+		if err == nil {
+			var targetSurface []string
+			targetSurface, err = commandSurface(ctx, target)
+			if err == nil {
+				var added []string
+				added, removed = diffCommandSurface(currentSurface, targetSurface)
+				displayCommandSurfaceDiff(added, removed, colors)
+			}
+		}
+		if err != nil {
+			fmt.Printf("  ⚠️  Could not list commands: %v\n", err)
+		}
+	}
+
+	// Usage history
+	fmt.Printf("\n%s\n", colors.Magenta.Sprint("## Your usage"))
+	entries, err := loadHistory(filepath.Join(utils.JFCMRoot, "history.json"))
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Printf("  ⚠️  Could not read history: %v\n", err)
+		return nil
+	}
+	impacts := analyzeUsageImpact(entries, current, notes, currentSurface, removed)
+	if len(impacts) == 0 {
+		fmt.Printf("  %s\n", colors.Green.Sprintf("✅ None of the commands you ran with %s touch changed areas", current))
+		return nil
+	}
+	fmt.Printf("  Commands you ran with %s that touch changed areas:\n", current)
+	for _, impact := range impacts {
+		fmt.Printf("  %s %s\n", colors.Yellow.Sprintf("• jf %s", impact.Command), fmt.Sprintf("(%d use(s))", impact.Uses))
+		for _, reason := range impact.Reasons {
+			fmt.Printf("      - %s\n", reason)
+		}
+	}
+
+	return nil
+}
+
+// displayChangelogSummary prints entry counts per category and every breaking change
+func displayChangelogSummary(notes []noteResult, colors *ColorScheme) {
+	grouped := consolidateReleaseNotes(notes)
+	var counts []string
+	for _, category := range noteCategoryOrder {
+		if n := len(grouped[category]); n > 0 {
+			counts = append(counts, fmt.Sprintf("%d %s", n, strings.ToLower(category)))
+		}
+	}
+	if len(counts) == 0 {
+		counts = append(counts, "no itemised changes")
+	}
+	fmt.Printf("  %d release(s): %s\n", len(notes), strings.Join(counts, ", "))
+
+	breaking := findBreakingChanges(notes)
+	if len(breaking) == 0 {
+		fmt.Printf("  %s\n", colors.Green.Sprint("✅ No breaking changes or deprecations found"))
+		return
+	}
+	fmt.Printf("  %s\n", colors.Red.Sprintf("⚠️  %d breaking change(s) or deprecation(s):", len(breaking)))
+	for _, match := range breaking {
+		fmt.Printf("    - %s %s\n", match.Text, colors.Blue.Sprintf("[%s, %s]", match.Tag, match.Reason))
+	}
+}
+
+// displayCommandSurfaceDiff prints the commands added and removed by the upgrade
+func displayCommandSurfaceDiff(added, removed []string, colors *ColorScheme) {
+	if len(added) == 0 && len(removed) == 0 {
+		fmt.Printf("  %s\n", colors.Green.Sprint("✅ Same commands in both versions"))
+		return
+	}
+	for _, command := range removed {
+		fmt.Printf("  %s\n", colors.Red.Sprintf("- jf %s", command))
+	}
+	for _, command := range added {
+		fmt.Printf("  %s\n", colors.Green.Sprintf("+ jf %s", command))
+	}
+}
+
+// missingVersions returns the versions among the given ones that are not installed
+func missingVersions(versions ...string) []string {
+	var missing []string
+	for _, version := range versions {
+		if utils.CheckVersionExists(version) != nil {
+			missing = append(missing, version)
+		}
+	}
+	return missing
+}
+
+// commandSurface lists the commands and subcommands ("rt upload") of an installed version
+func commandSurface(ctx context.Context, version string) ([]string, error) {
+	result, err := executeJFCommand(ctx, version, []string{"--help"}, nil)
+	if err != nil {
+		return nil, err
+	}
+	topLevel := parseHelpCommands(result.Output)
+	if len(topLevel) == 0 {
+		return nil, fmt.Errorf("jf %s --help listed no commands", version)
+	}
+
+	var mu sync.Mutex
+	surface := append([]string{}, topLevel...)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(helpProbeConcurrency)
+	for _, command := range topLevel {
+		if command == "help" {
+			continue
+		}
+		command := command
+		g.Go(func() error {
+			sub, err := executeJFCommand(gctx, version, []string{command, "--help"}, nil)
+			if err != nil {
+				return nil
+			}
+			for _, subcommand := range parseHelpCommands(sub.Output) {
+				if subcommand == "help" {
+					continue
+				}
+				mu.Lock()
+				surface = append(surface, command+" "+subcommand)
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	g.Wait()
+
+	sort.Strings(surface)
+	return surface, nil
+}
+
+// helpCommandPattern matches a command line of a urfave/cli COMMANDS section: "name, alias   description"
+var helpCommandPattern = regexp.MustCompile(`^\s+([a-z][\w-]*)(,\s*[\w-]+)*(\s{2,}.*)?$`)
+
+// parseHelpCommands extracts the command names from the COMMANDS section of jf help output
+func parseHelpCommands(help string) []string {
+	var commands []string
+	inCommands := false
+
+	for _, line := range strings.Split(help, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+			// Unindented lines are section titles such as COMMANDS: or OPTIONS:
+			inCommands = strings.HasPrefix(trimmed, "COMMANDS")
+			continue
+		}
+		if !inCommands || strings.HasSuffix(trimmed, ":") {
+			// Category headings inside COMMANDS end with a colon
+			continue
+		}
+		if match := helpCommandPattern.FindStringSubmatch(line); match != nil {
+			commands = append(commands, match[1])
+		}
+	}
+
+	return commands
+}
+
+// diffCommandSurface returns the commands only present in target (added) and only in current (removed)
+func diffCommandSurface(current, target []string) ([]string, []string) {
+	inCurrent := make(map[string]bool)
+	for _, command := range current {
+		inCurrent[command] = true
+	}
+	inTarget := make(map[string]bool)
+	for _, command := range target {
+		inTarget[command] = true
+	}
+
+	var added, removed []string
+	for _, command := range target {
+		if !inCurrent[command] {
+			added = append(added, command)
+		}
+	}
+	for _, command := range current {
+		if !inTarget[command] {
+			removed = append(removed, command)
+		}
+	}
+	return added, removed
+}
+
+// historyCommandPath returns the command path of a recorded jf invocation ("jf rt u x y" → "rt u")
+// and the flags it used. When the known command surface is given, arguments are told apart from
+// subcommands with it; otherwise the first two words are assumed to be the command path.
+func historyCommandPath(command string, known map[string]bool) (string, []string) {
+	fields := strings.Fields(command)
+	if len(fields) > 0 && filepath.Base(fields[0]) == utils.BinaryName {
+		fields = fields[1:]
+	}
+
+	var path, flags []string
+	for _, field := range fields {
+		if strings.HasPrefix(field, "-") {
+			name := strings.SplitN(field, "=", 2)[0]
+			if strings.HasPrefix(name, "--") {
+				flags = append(flags, name)
+			}
+			continue
+		}
+		if len(path) < 2 && len(flags) == 0 {
+			path = append(path, field)
+		}
+	}
+	if len(path) == 2 && len(known) > 0 && !known[strings.Join(path, " ")] {
+		path = path[:1]
+	}
+	return strings.Join(path, " "), flags
+}
+
+// analyzeUsageImpact finds the commands run with version that were removed or are mentioned
+// in the release notes of the upgrade range. surface is the command surface of version, if known.
+func analyzeUsageImpact(entries []HistoryEntry, version string, notes []noteResult, surface, removed []string) []UsageImpact {
+	known := make(map[string]bool)
+	for _, command := range surface {
+		known[command] = true
+	}
+
+	uses := make(map[string]int)
+	flagsByPath := make(map[string]map[string]bool)
+	var order []string
+
+	for _, entry := range entries {
+		if entry.Version != version {
+			continue
+		}
+		path, flags := historyCommandPath(entry.Command, known)
+		if path == "" {
+			continue
+		}
+		if _, seen := uses[path]; !seen {
+			order = append(order, path)
+			flagsByPath[path] = make(map[string]bool)
+		}
+		uses[path]++
+		for _, flag := range flags {
+			flagsByPath[path][flag] = true
+		}
+	}
+
+	removedSet := make(map[string]bool)
+	for _, command := range removed {
+		removedSet[command] = true
+	}
+
+	var entriesInRange []ReleaseNoteEntry
+	for _, note := range notes {
+		entriesInRange = append(entriesInRange, parseReleaseNoteEntries(note.Tag, note.Body)...)
+	}
+	breakingTexts := make(map[string]bool)
+	for _, match := range findBreakingChanges(notes) {
+		breakingTexts[match.Tag+"\x00"+match.Text] = true
+	}
+
+	var impacts []UsageImpact
+	for _, path := range order {
+		var reasons []string
+
+		if removedSet[path] || removedSet[strings.Fields(path)[0]] {
+			reasons = append(reasons, "command no longer listed in the target version")
+		}
+
+		mention := regexp.MustCompile(`(?i)(^|[^\w-])` + regexp.QuoteMeta(path) + `($|[^\w-])`)
+		var mentionedIn, breakingIn []string
+		for _, entry := range entriesInRange {
+			if !mention.MatchString(entry.Text) {
+				continue
+			}
+			if breakingTexts[entry.Tag+"\x00"+entry.Text] {
+				breakingIn = appendUnique(breakingIn, entry.Tag)
+			} else {
+				mentionedIn = appendUnique(mentionedIn, entry.Tag)
+			}
+		}
+		if len(breakingIn) > 0 {
+			reasons = append(reasons, fmt.Sprintf("breaking change or deprecation in %s", strings.Join(breakingIn, ", ")))
+		}
+		if len(mentionedIn) > 0 {
+			reasons = append(reasons, fmt.Sprintf("changed in %s", strings.Join(mentionedIn, ", ")))
+		}
+
+		var flags []string
+		for flag := range flagsByPath[path] {
+			flags = append(flags, flag)
+		}
+		sort.Strings(flags)
+		for _, flag := range flags {
+			var flagIn []string
+			for _, entry := range entriesInRange {
+				if breakingTexts[entry.Tag+"\x00"+entry.Text] && strings.Contains(entry.Text, flag) {
+					flagIn = appendUnique(flagIn, entry.Tag)
+				}
+			}
+			if len(flagIn) > 0 {
+				reasons = append(reasons, fmt.Sprintf("flag %s affected by a breaking change in %s", flag, strings.Join(flagIn, ", ")))
+			}
+		}
+
+		if len(reasons) > 0 {
+			impacts = append(impacts, UsageImpact{Command: path, Uses: uses[path], Reasons: reasons})
+		}
+	}
+
+	return impacts
+}
+
+// appendUnique appends value unless it is already present
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseHelpCommands(t *testing.T) {
+	help := `NAME:
+   jf - See https://github.com/jfrog/jfrog-cli for usage instructions.
+
+COMMANDS:
+   Artifactory:
+     rt                  Artifactory commands.
+   Other:
+     login               Login
+     intro, i            Show intro
+     help, h             Shows a list of commands or help for one command
+
+GLOBAL OPTIONS:
+   --help, -h     show help`
+
+	got := parseHelpCommands(help)
+	want := []string{"rt", "login", "intro", "help"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseHelpCommands() = %v, want %v", got, want)
+	}
+}
+
+func TestAnalyzeUsageImpact(t *testing.T) {
+	entries := []HistoryEntry{
+		{Version: "2.55.0", Command: "jf rt upload a.jar repo/ --flat=false"},
+		{Version: "2.55.0", Command: "jf rt upload b.jar repo/"},
+		{Version: "2.55.0", Command: "jf legacy-cmd foo"},
+		{Version: "2.55.0", Command: "jf rt ping"},
+		{Version: "2.60.0", Command: "jf rt search x"},
+	}
+	notes := []noteResult{
+		{Tag: "v2.58.0", Body: "## Bug Fixes\n- rt upload now retries on 503"},
+		{Tag: "v2.59.0", Body: "## Breaking Changes\n- The --flat flag of rt upload defaults to true"},
+	}
+	surface := []string{"legacy-cmd", "rt", "rt ping", "rt upload"}
+	_, removed := diffCommandSurface(surface, []string{"rt", "rt ping", "rt upload"})
+
+	impacts := analyzeUsageImpact(entries, "2.55.0", notes, surface, removed)
+	if len(impacts) != 2 {
+		t.Fatalf("expected 2 impacted commands, got %+v", impacts)
+	}
+
+	upload := impacts[0]
+	if upload.Command != "rt upload" || upload.Uses != 2 {
+		t.Errorf("unexpected upload impact: %+v", upload)
+	}
+	reasons := strings.Join(upload.Reasons, "; ")
+	for _, expected := range []string{"breaking change or deprecation in v2.59.0", "changed in v2.58.0", "flag --flat"} {
+		if !strings.Contains(reasons, expected) {
+			t.Errorf("upload reasons %q missing %q", reasons, expected)
+		}
+	}
+
+	if legacy := impacts[1]; legacy.Command != "legacy-cmd" || !strings.Contains(legacy.Reasons[0], "no longer listed") {
+		t.Errorf("unexpected legacy impact: %+v", legacy)
+	}
+}
@@ -17,6 +17,7 @@ var Use = &cli.Command{
 	Usage:       descriptions.Use.Usage,
 	ArgsUsage:   "[version or alias] (optional if .jfrog-version exists)",
 	Description: descriptions.Use.Format(),
+	// The state lock is only taken for the activation; downloads are serialized by the version lock
 	Action: func(c *cli.Context) error {
 		fmt.Println("Executing 'jfcm use' command...")
 		var version string
@@ -107,15 +108,11 @@ var Use = &cli.Command{
 			return cli.Exit(fmt.Sprintf("%v", err), 1)
 		}
 
-		fmt.Printf("Writing selected version '%s' to config file: %s\n", version, utils.JFCMConfig)
-		if err := os.WriteFile(utils.JFCMConfig, []byte(version), 0644); err != nil {
-			return fmt.Errorf("failed to write config file: %w", err)
+		if err := activateVersion(version); err != nil {
+			return err
 		}
-
-		// Set up shim to redirect jf commands to the active version
-		fmt.Println("Setting up jf shim...")
-		if err := utils.SetupShim(); err != nil {
-			return fmt.Errorf("failed to setup shim: %w", err)
+		if err := utils.TouchVersionLastUsed(version); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: failed to update version metadata: %v\n", err)
 		}
 
 		// Update PATH to prioritize jfcm-managed jf over system jf
@@ -134,6 +131,7 @@ var Use = &cli.Command{
 		} else {
 			fmt.Println("✅ Priority verification successful")
 		}
+		printJfInstallations(utils.FindJfInstallations(os.Getenv("PATH"), true))
 
 		fmt.Printf("✅ Successfully activated jf version %s\n", version)
 		fmt.Printf("🔧 jfcm-managed jf binary now takes highest priority over system installations\n")
@@ -143,3 +141,25 @@ var Use = &cli.Command{
 		return nil
 	},
 }
+
+// activateVersion makes an installed version the active one and writes the shim redirecting to it,
+// holding the state lock so a concurrent remove cannot delete the version in between
+func activateVersion(version string) error {
+	stateLock, err := utils.LockState()
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("❌ %v", err), 1)
+	}
+	defer stateLock.Release()
+
+	fmt.Printf("Writing selected version '%s' to config file: %s\n", version, utils.JFCMConfig)
+	if err := utils.SwitchToVersion(version); err != nil {
+		return err
+	}
+
+	// Set up shim to redirect jf commands to the active version
+	fmt.Println("Setting up jf shim...")
+	if err := utils.SetupShim(); err != nil {
+		return fmt.Errorf("failed to setup shim: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestCopyDir(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks and permission bits differ on windows")
+	}
+
+	src := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(src, "security"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "jfrog-cli.conf.v6"), []byte(`{"servers": []}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "security", "cert.pem"), []byte("cert"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("jfrog-cli.conf.v6", filepath.Join(src, "current.conf")); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := filepath.Join(t.TempDir(), "copy")
+	if err := CopyDir(src, dst); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Stat(filepath.Join(dst, "jfrog-cli.conf.v6"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected the config to keep mode 0600, got %v", info.Mode().Perm())
+	}
+	if got, _ := os.ReadFile(filepath.Join(dst, "security", "cert.pem")); string(got) != "cert" {
+		t.Errorf("expected the nested file to be copied, got %q", got)
+	}
+	// The relative link is copied as a link, so it points into the copy
+	link, err := os.Readlink(filepath.Join(dst, "current.conf"))
+	if err != nil || link != "jfrog-cli.conf.v6" {
+		t.Errorf("expected a symlink to jfrog-cli.conf.v6, got %q: %v", link, err)
+	}
+	if got, _ := os.ReadFile(filepath.Join(dst, "current.conf")); string(got) != `{"servers": []}` {
+		t.Errorf("expected the symlink to resolve to the copied config, got %q", got)
+	}
+}
@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-manager/internal/lock"
+)
+
+const (
+	// StateLockTimeout bounds how long a command waits for another jfcm process to finish
+	StateLockTimeout = 2 * time.Minute
+
+	// HistoryLockTimeout is short since history is recorded in the background after every jf command
+	HistoryLockTimeout = 5 * time.Second
+)
+
+// LockState takes the lock guarding the config file, aliases, the block file and settings
+func LockState() (*lock.Lock, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), StateLockTimeout)
+	defer cancel()
+	l, _, err := acquireLock(ctx, "state", "another jfcm command")
+	return l, err
+}
+
+// LockHistory takes the lock guarding history.json
+func LockHistory() (*lock.Lock, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), HistoryLockTimeout)
+	defer cancel()
+	return lock.Acquire(ctx, filepath.Join(JFCMLocks, "history.lock"), nil)
+}
+
+// LockVersion takes the lock guarding the download of a version for a platform. waited reports whether
+// another process held it, in which case that process may have installed the version in the meantime.
+func LockVersion(ctx context.Context, platform, version string) (l *lock.Lock, waited bool, err error) {
+	name := "install-" + version
+	if platform != HostPlatform() {
+		name = "install-" + platform + "-" + version
+	}
+	return acquireLock(ctx, name, "another jfcm process installing "+version)
+}
+
+// acquireLock takes a named lock, telling the user when it has to wait for holder
+func acquireLock(ctx context.Context, name, holder string) (l *lock.Lock, waited bool, err error) {
+	path := filepath.Join(JFCMLocks, name+".lock")
+	l, err = lock.Acquire(ctx, path, func() {
+		waited = true
+		fmt.Fprintf(os.Stderr, "⏳ Waiting for %s to finish...\n", holder)
+	})
+	if err != nil {
+		return nil, waited, fmt.Errorf("%s is still running: %w", holder, err)
+	}
+	return l, waited, nil
+}
@@ -0,0 +1,221 @@
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// MetaFileName is the metadata file stored next to each installed binary
+const MetaFileName = "meta.json"
+
+// LastUsedMarker is an empty file next to each installed binary that the shim rewrites on every jf
+// call, so its modification time is when the version was last run through the shim
+const LastUsedMarker = ".last-used"
+
+// How a version was installed
+const (
+	InstallerDownload = "download"
+	InstallerLink     = "link"
+	InstallerImport   = "import"
+	InstallerUnknown  = "unknown"
+)
+
+// VersionMeta records where an installed version came from and when it was last used
+type VersionMeta struct {
+	SourceURL   string    `json:"source_url,omitempty"`
+	InstalledAt time.Time `json:"installed_at"`
+	SHA256      string    `json:"sha256"`
+	Size        int64     `json:"size"`
+	Platform    string    `json:"platform"`
+	Installer   string    `json:"installer"`
+	LastUsedAt  time.Time `json:"last_used_at,omitzero"`
+	// Backfilled is set for versions installed before metadata was recorded; the digest
+	// was taken when the metadata was first needed rather than at install time
+	Backfilled bool `json:"backfilled,omitempty"`
+}
+
+// HostPlatform returns the platform of the running jfcm, such as "linux-amd64"
+func HostPlatform() string {
+	return runtime.GOOS + "-" + runtime.GOARCH
+}
+
+// PlatformVersionsDir returns the versions directory of a platform: the regular versions directory
+// for the host platform, and a cache under ~/.jfcm/platforms for other platforms
+func PlatformVersionsDir(platform string) string {
+	if platform == "" || platform == HostPlatform() {
+		return JFCMVersions
+	}
+	return filepath.Join(JFCMRoot, PlatformsDir, platform, VersionsDir)
+}
+
+// ValidateVersionName checks that a version read from an untrusted source, such as a bundle manifest
+// or another binary's output, is a semantic version that is safe to use as a directory name
+func ValidateVersionName(version string) error {
+	if strings.ContainsAny(version, `/\`) || strings.Contains(version, "..") {
+		return fmt.Errorf("invalid version %q", version)
+	}
+	if _, err := ParseVersion(version); err != nil {
+		return fmt.Errorf("invalid version %q: %w", version, err)
+	}
+	return nil
+}
+
+// ValidateVersionDirName checks that a version or linked version name given by the user is a single
+// path element, so it names a directory directly under the versions directory
+func ValidateVersionDirName(name string) error {
+	if name == "" || name == "." || !filepath.IsLocal(name) || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid version name %q", name)
+	}
+	return nil
+}
+
+// VersionMetaPath returns the metadata file of a version
+func VersionMetaPath(version string) string {
+	return filepath.Join(JFCMVersions, version, MetaFileName)
+}
+
+// LoadVersionMeta reads the metadata of a version. The error satisfies os.IsNotExist when none was recorded.
+func LoadVersionMeta(version string) (*VersionMeta, error) {
+	return LoadVersionMetaIn(JFCMVersions, version)
+}
+
+// LoadVersionMetaIn reads the metadata of a version stored under versionsDir
+func LoadVersionMetaIn(versionsDir, version string) (*VersionMeta, error) {
+	path := filepath.Join(versionsDir, version, MetaFileName)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var meta VersionMeta
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if meta.Installer == "" {
+		meta.Installer = InstallerUnknown
+	}
+	return &meta, nil
+}
+
+// SaveVersionMeta writes the metadata of a version atomically
+func SaveVersionMeta(version string, meta *VersionMeta) error {
+	return SaveVersionMetaIn(JFCMVersions, version, meta)
+}
+
+// SaveVersionMetaIn writes the metadata of a version stored under versionsDir
+func SaveVersionMetaIn(versionsDir, version string, meta *VersionMeta) error {
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode metadata: %w", err)
+	}
+
+	if err := WriteFileAtomic(filepath.Join(versionsDir, version, MetaFileName), data, 0644); err != nil {
+		return fmt.Errorf("failed to save metadata: %w", err)
+	}
+	return nil
+}
+
+// HashFile returns the hex SHA-256 digest and size of a file
+func HashFile(path string) (string, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// WriteVersionMeta records a freshly installed binary: it hashes the binary, fills in the
+// install time and platform when unset, and saves the metadata
+func WriteVersionMeta(version string, meta VersionMeta) (*VersionMeta, error) {
+	return WriteVersionMetaIn(JFCMVersions, version, meta)
+}
+
+// WriteVersionMetaIn records a freshly installed binary stored under versionsDir
+func WriteVersionMetaIn(versionsDir, version string, meta VersionMeta) (*VersionMeta, error) {
+	digest, size, err := HashFile(filepath.Join(versionsDir, version, BinaryName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash binary of %s: %w", version, err)
+	}
+	meta.SHA256, meta.Size = digest, size
+	if meta.InstalledAt.IsZero() {
+		meta.InstalledAt = time.Now().UTC()
+	}
+	if meta.Platform == "" {
+		meta.Platform = HostPlatform()
+	}
+	if meta.Installer == "" {
+		meta.Installer = InstallerUnknown
+	}
+
+	if err := SaveVersionMetaIn(versionsDir, version, &meta); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+// EnsureVersionMeta returns the metadata of a version, backfilling it from the installed binary
+// for versions installed before metadata was recorded. backfilled reports whether that happened now.
+func EnsureVersionMeta(version string) (meta *VersionMeta, backfilled bool, err error) {
+	meta, err = LoadVersionMeta(version)
+	if err == nil || !os.IsNotExist(err) {
+		return meta, false, err
+	}
+
+	binPath := filepath.Join(JFCMVersions, version, BinaryName)
+	info, err := os.Stat(binPath)
+	if err != nil {
+		return nil, false, fmt.Errorf("version %s has no binary: %w", version, err)
+	}
+	meta, err = WriteVersionMeta(version, VersionMeta{
+		InstalledAt: info.ModTime().UTC(),
+		Installer:   InstallerUnknown,
+		Backfilled:  true,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return meta, true, nil
+}
+
+// MarkerLastUsed returns when the shim last ran a version, or the zero time if it never did
+func MarkerLastUsed(version string) time.Time {
+	info, err := os.Stat(filepath.Join(JFCMVersions, version, LastUsedMarker))
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime().UTC()
+}
+
+// TouchVersionLastUsed records that a version was just used. It holds the install lock of the version
+// so the read-modify-write of meta.json does not race with an install or another touch.
+func TouchVersionLastUsed(version string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), StateLockTimeout)
+	defer cancel()
+	versionLock, _, err := LockVersion(ctx, HostPlatform(), version)
+	if err != nil {
+		return err
+	}
+	defer versionLock.Release()
+
+	meta, _, err := EnsureVersionMeta(version)
+	if err != nil {
+		return err
+	}
+	meta.LastUsedAt = time.Now().UTC()
+	return SaveVersionMeta(version, meta)
+}
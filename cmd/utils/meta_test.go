@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVersionMetaBackfillAndLastUsed(t *testing.T) {
+	oldVersions := JFCMVersions
+	JFCMVersions = t.TempDir()
+	defer func() { JFCMVersions = oldVersions }()
+
+	dir := filepath.Join(JFCMVersions, "2.50.0")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	binPath := filepath.Join(dir, BinaryName)
+	if err := os.WriteFile(binPath, []byte("binary"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	installed := time.Date(2025, 3, 2, 10, 0, 0, 0, time.UTC)
+	if err := os.Chtimes(binPath, installed, installed); err != nil {
+		t.Fatal(err)
+	}
+
+	meta, backfilled, err := EnsureVersionMeta("2.50.0")
+	if err != nil || !backfilled {
+		t.Fatalf("expected a backfill, got %v %v", backfilled, err)
+	}
+	if !meta.Backfilled || meta.Installer != InstallerUnknown || !meta.InstalledAt.Equal(installed) || meta.Size != 6 {
+		t.Errorf("unexpected backfilled metadata: %+v", meta)
+	}
+
+	data, err := os.ReadFile(VersionMetaPath("2.50.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "last_used_at") {
+		t.Errorf("an unused version should not have last_used_at: %s", data)
+	}
+
+	if err := TouchVersionLastUsed("2.50.0"); err != nil {
+		t.Fatal(err)
+	}
+	meta, backfilled, err = EnsureVersionMeta("2.50.0")
+	if err != nil || backfilled {
+		t.Fatalf("expected stored metadata, got %v %v", backfilled, err)
+	}
+	if meta.LastUsedAt.IsZero() || meta.SHA256 == "" {
+		t.Errorf("expected last-used time and digest to be kept, got %+v", meta)
+	}
+
+	if _, _, err := EnsureVersionMeta("9.9.9"); err == nil {
+		t.Errorf("expected an error for a version without a binary")
+	}
+}
+
+func TestWriteVersionMeta(t *testing.T) {
+	oldVersions := JFCMVersions
+	JFCMVersions = t.TempDir()
+	defer func() { JFCMVersions = oldVersions }()
+
+	dir := filepath.Join(JFCMVersions, "local-dev")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, BinaryName), []byte("jf"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := WriteVersionMeta("local-dev", VersionMeta{SourceURL: "file:///tmp/jf", Installer: InstallerLink}); err != nil {
+		t.Fatal(err)
+	}
+	meta, err := LoadVersionMeta("local-dev")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// sha256("jf")
+	if meta.SHA256 != "ba19ebbc3493f16045d196ecddceef7ef4a0776059ca8aebce75b2dd6f556716" {
+		t.Errorf("unexpected digest %q", meta.SHA256)
+	}
+	if meta.Installer != InstallerLink || meta.Platform != HostPlatform() || meta.Size != 2 || meta.InstalledAt.IsZero() || meta.Backfilled {
+		t.Errorf("unexpected metadata: %+v", meta)
+	}
+}
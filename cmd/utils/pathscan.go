@@ -0,0 +1,206 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// Origins of a jf executable found on PATH
+const (
+	OriginJFCM     = "jfcm"
+	OriginHomebrew = "Homebrew"
+	OriginNpm      = "npm"
+	OriginManual   = "manual"
+)
+
+// jfVersionTimeout bounds the `jf --version` call made for each installation
+const jfVersionTimeout = 5 * time.Second
+
+// JfInstallation is a jf executable found in a PATH directory
+type JfInstallation struct {
+	Path      string `json:"path"`
+	Resolved  string `json:"resolved"`
+	PathIndex int    `json:"path_index"`
+	Origin    string `json:"origin"`
+	Version   string `json:"version,omitempty"`
+	Active    bool   `json:"active"`
+}
+
+// IsJFCM reports whether the installation is managed by jfcm
+func (i JfInstallation) IsJFCM() bool {
+	return i.Origin == OriginJFCM
+}
+
+// Describe returns a one-line description such as "Homebrew jf 2.50.0 at /opt/homebrew/bin/jf"
+func (i JfInstallation) Describe() string {
+	version := i.Version
+	if version == "" {
+		version = "(unknown version)"
+	}
+	return fmt.Sprintf("%s jf %s at %s", i.Origin, version, i.Path)
+}
+
+// jfExecutableNames returns the file names resolved as jf on this platform
+func jfExecutableNames() []string {
+	if runtime.GOOS == "windows" {
+		return []string{BinaryName + ".exe", BinaryName + ".bat", BinaryName + ".cmd", BinaryName}
+	}
+	return []string{BinaryName}
+}
+
+// FindJfInstallations lists every jf executable on pathEnv in resolution order. The first entry
+// is the one the shell runs. Directories listed twice and symlinks to an already listed binary
+// are skipped. Versions are read by running each binary when withVersions is set.
+func FindJfInstallations(pathEnv string, withVersions bool) []JfInstallation {
+	var installations []JfInstallation
+	seenDirs := make(map[string]bool)
+	seenBinaries := make(map[string]bool)
+
+	for index, dir := range filepath.SplitList(pathEnv) {
+		if dir == "" {
+			continue
+		}
+		dir = filepath.Clean(dir)
+		if seenDirs[dir] {
+			continue
+		}
+		seenDirs[dir] = true
+
+		for _, name := range jfExecutableNames() {
+			candidate := filepath.Join(dir, name)
+			if !isExecutableFile(candidate) {
+				continue
+			}
+			resolved, err := filepath.EvalSymlinks(candidate)
+			if err != nil {
+				resolved = candidate
+			}
+			if seenBinaries[resolved] {
+				break
+			}
+			seenBinaries[resolved] = true
+
+			installation := JfInstallation{
+				Path:      candidate,
+				Resolved:  resolved,
+				PathIndex: index,
+				Origin:    ClassifyJfOrigin(candidate, resolved),
+				Active:    len(installations) == 0,
+			}
+			if withVersions {
+				installation.Version, _ = JfBinaryVersion(candidate)
+			}
+			installations = append(installations, installation)
+			break
+		}
+	}
+	return installations
+}
+
+// isExecutableFile reports whether path is a regular file the current user may execute
+func isExecutableFile(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	return runtime.GOOS == "windows" || info.Mode()&0111 != 0
+}
+
+// ClassifyJfOrigin guesses who installed a jf binary from its path and symlink target
+func ClassifyJfOrigin(path, resolved string) string {
+	for _, p := range []string{path, resolved} {
+		if isWithin(p, JFCMShim) || isWithin(p, JFCMVersions) {
+			return OriginJFCM
+		}
+	}
+
+	for _, p := range []string{resolved, path} {
+		slashed := filepath.ToSlash(p)
+		switch {
+		case strings.Contains(slashed, "/node_modules/"):
+			return OriginNpm
+		case strings.Contains(slashed, "/Cellar/"), strings.Contains(slashed, "/homebrew/"),
+			strings.Contains(slashed, "/linuxbrew/"):
+			return OriginHomebrew
+		}
+	}
+	return OriginManual
+}
+
+// isWithin reports whether path is dir or inside it
+func isWithin(path, dir string) bool {
+	if dir == "" {
+		return false
+	}
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(path))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// JfBinaryVersion runs `<path> --version` and returns the version number it prints
+func JfBinaryVersion(path string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), jfVersionTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, path, "--version")
+	// Disable history recording when the binary is the jfcm shim
+	cmd.Env = append(os.Environ(), "jfcm_NO_HISTORY=1")
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to run %s --version: %w", path, err)
+	}
+
+	// jf prints "jf version 2.50.0"
+	fields := strings.Fields(strings.SplitN(strings.TrimSpace(string(output)), "\n", 2)[0])
+	if len(fields) == 0 {
+		return "", fmt.Errorf("%s --version printed nothing", path)
+	}
+	return strings.TrimPrefix(fields[len(fields)-1], "v"), nil
+}
+
+// AdoptJfBinary copies an installation into the jfcm versions directory under its version.
+// It returns the version, and does nothing when that version is already installed.
+func AdoptJfBinary(installation JfInstallation) (string, error) {
+	if installation.IsJFCM() {
+		return "", fmt.Errorf("%s is already managed by jfcm", installation.Path)
+	}
+	version := installation.Version
+	if version == "" {
+		var err error
+		if version, err = JfBinaryVersion(installation.Path); err != nil {
+			return "", err
+		}
+	}
+	// The version comes from the binary's own output and becomes a directory name
+	if err := ValidateVersionName(version); err != nil {
+		return "", fmt.Errorf("%s reports an unusable version: %w", installation.Path, err)
+	}
+
+	targetDir := filepath.Join(JFCMVersions, version)
+	targetBin := filepath.Join(targetDir, BinaryName)
+	if _, err := os.Stat(targetBin); err == nil {
+		return version, nil
+	}
+
+	if err := os.MkdirAll(targetDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create version directory: %w", err)
+	}
+	if err := CopyFile(installation.Resolved, targetBin); err != nil {
+		return "", fmt.Errorf("failed to copy %s: %w", installation.Resolved, err)
+	}
+	if err := os.Chmod(targetBin, 0755); err != nil {
+		return "", fmt.Errorf("failed to make %s executable: %w", targetBin, err)
+	}
+	if _, err := WriteVersionMeta(version, VersionMeta{
+		SourceURL: "file://" + filepath.ToSlash(installation.Resolved),
+		Installer: InstallerLink,
+	}); err != nil {
+		return "", err
+	}
+	return version, nil
+}
@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// writeFakeJf writes an executable jf script printing version into dir
+func writeFakeJf(t *testing.T, dir, version string) string {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("failed to create %s: %v", dir, err)
+	}
+	path := filepath.Join(dir, BinaryName)
+	script := "#!/bin/sh\necho \"jf version " + version + "\"\n"
+	if err := os.WriteFile(path, []byte(script), 0755); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+	return path
+}
+
+func TestFindJfInstallations(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses shell scripts as fake jf binaries")
+	}
+
+	root := t.TempDir()
+	oldShim, oldVersions := JFCMShim, JFCMVersions
+	JFCMShim, JFCMVersions = filepath.Join(root, "jfcm", "shim"), filepath.Join(root, "jfcm", "versions")
+	defer func() { JFCMShim, JFCMVersions = oldShim, oldVersions }()
+
+	brew := writeFakeJf(t, filepath.Join(root, "homebrew", "Cellar", "jfrog-cli", "2.50.0", "bin"), "2.50.0")
+	brewBin := filepath.Join(root, "brew-bin")
+	if err := os.MkdirAll(brewBin, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(brew, filepath.Join(brewBin, BinaryName)); err != nil {
+		t.Fatal(err)
+	}
+	writeFakeJf(t, JFCMShim, "2.60.0")
+	manual := writeFakeJf(t, filepath.Join(root, "opt", "tools"), "2.40.1")
+	empty := filepath.Join(root, "empty")
+
+	pathEnv := strings.Join([]string{empty, brewBin, JFCMShim, brewBin, filepath.Dir(manual)}, string(os.PathListSeparator))
+	installations := FindJfInstallations(pathEnv, true)
+	if len(installations) != 3 {
+		t.Fatalf("expected 3 installations, got %+v", installations)
+	}
+
+	expected := []struct{ origin, version string }{
+		{OriginHomebrew, "2.50.0"},
+		{OriginJFCM, "2.60.0"},
+		{OriginManual, "2.40.1"},
+	}
+	for i, want := range expected {
+		got := installations[i]
+		if got.Origin != want.origin || got.Version != want.version {
+			t.Errorf("installation %d: expected %s %s, got %+v", i, want.origin, want.version, got)
+		}
+		if got.Active != (i == 0) {
+			t.Errorf("installation %d: unexpected active flag", i)
+		}
+	}
+	if installations[0].Resolved != brew || installations[0].PathIndex != 1 {
+		t.Errorf("expected the symlink to resolve to %s at PATH index 1, got %+v", brew, installations[0])
+	}
+
+	// Adopting copies the stray binary under its version
+	version, err := AdoptJfBinary(installations[2])
+	if err != nil || version != "2.40.1" {
+		t.Fatalf("unexpected adoption result %q: %v", version, err)
+	}
+	if got, _ := JfBinaryVersion(filepath.Join(JFCMVersions, "2.40.1", BinaryName)); got != "2.40.1" {
+		t.Errorf("adopted binary reports version %q", got)
+	}
+	if _, err := AdoptJfBinary(installations[1]); err == nil {
+		t.Errorf("expected an error adopting the jfcm shim")
+	}
+
+	// A binary reporting a version that is not a plain directory name is not adopted
+	hostile := JfInstallation{Path: writeFakeJf(t, filepath.Join(root, "hostile"), "../../escaped")}
+	hostile.Resolved = hostile.Path
+	if _, err := AdoptJfBinary(hostile); err == nil || !strings.Contains(err.Error(), "invalid version") {
+		t.Errorf("expected an invalid version error, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "escaped")); !os.IsNotExist(err) {
+		t.Errorf("expected no directory outside the versions directory")
+	}
+}
+
+func TestClassifyJfOrigin(t *testing.T) {
+	cases := map[string]string{
+		"/usr/local/lib/node_modules/jfrog-cli-v2-jf/bin/jf": OriginNpm,
+		"/opt/homebrew/bin/jf":                               OriginHomebrew,
+		"/usr/local/Cellar/jfrog-cli/2.50.0/bin/jf":          OriginHomebrew,
+		"/home/linuxbrew/.linuxbrew/bin/jf":                  OriginHomebrew,
+		"/usr/local/bin/jf":                                  OriginManual,
+	}
+	for path, want := range cases {
+		if got := ClassifyJfOrigin(path, path); got != want {
+			t.Errorf("%s: expected %s, got %s", path, want, got)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	SettingsFile        = "settings.json"
+	CacheDir            = "cache"
+	DefaultGitHubAPIURL = "https://api.github.com"
+	DefaultMirrorURL    = "https://releases.jfrog.io/artifactory/jfrog-cli"
+)
+
+// Setting keys accepted by `jfcm settings`
+const (
+	SettingGitHubAPIURL = "github-api-url"
+	SettingGitHubToken  = "github-token"
+	SettingMirrorURL    = "mirror-url"
+)
+
+// Environment variables that take precedence over the settings file
+const (
+	EnvGitHubAPIURL = "JFCM_GITHUB_API_URL"
+	EnvGitHubToken  = "JFCM_GITHUB_TOKEN"
+	EnvMirrorURL    = "JFCM_MIRROR_URL"
+)
+
+var (
+	JFCMSettings = filepath.Join(JFCMRoot, SettingsFile)
+	JFCMCache    = filepath.Join(JFCMRoot, CacheDir)
+)
+
+// Settings holds user configurable jfcm options persisted in ~/.jfcm/settings.json
+type Settings struct {
+	GitHubAPIURL string `json:"github_api_url,omitempty"`
+	GitHubToken  string `json:"github_token,omitempty"`
+	MirrorURL    string `json:"mirror_url,omitempty"`
+}
+
+// settingFields maps every setting key to its field in Settings
+func (s *Settings) settingFields() map[string]*string {
+	return map[string]*string{
+		SettingGitHubAPIURL: &s.GitHubAPIURL,
+		SettingGitHubToken:  &s.GitHubToken,
+		SettingMirrorURL:    &s.MirrorURL,
+	}
+}
+
+// SettingKeys returns all supported setting keys in sorted order
+func SettingKeys() []string {
+	keys := make([]string, 0)
+	for key := range (&Settings{}).settingFields() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// IsSecretSetting reports whether a setting value should be masked when displayed
+func IsSecretSetting(key string) bool {
+	return key == SettingGitHubToken
+}
+
+// LoadSettings reads the settings file. A missing file yields empty settings.
+func LoadSettings() (*Settings, error) {
+	settings := &Settings{}
+	data, err := os.ReadFile(JFCMSettings)
+	if os.IsNotExist(err) {
+		return settings, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read settings: %w", err)
+	}
+	if err := json.Unmarshal(data, settings); err != nil {
+		return nil, fmt.Errorf("failed to parse settings file %s: %w", JFCMSettings, err)
+	}
+	return settings, nil
+}
+
+// SaveSettings writes the settings file. It is only readable by the owner since it may hold tokens.
+func SaveSettings(settings *Settings) error {
+	if err := os.MkdirAll(JFCMRoot, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", JFCMRoot, err)
+	}
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode settings: %w", err)
+	}
+	if err := WriteFileAtomic(JFCMSettings, data, 0600); err != nil {
+		return fmt.Errorf("failed to write settings: %w", err)
+	}
+	return nil
+}
+
+// Get returns the stored value of a setting key
+func (s *Settings) Get(key string) (string, error) {
+	field, ok := s.settingFields()[key]
+	if !ok {
+		return "", fmt.Errorf("unknown setting '%s' (supported: %s)", key, strings.Join(SettingKeys(), ", "))
+	}
+	return *field, nil
+}
+
+// Set updates a setting key. An empty value resets it to the default.
+func (s *Settings) Set(key, value string) error {
+	field, ok := s.settingFields()[key]
+	if !ok {
+		return fmt.Errorf("unknown setting '%s' (supported: %s)", key, strings.Join(SettingKeys(), ", "))
+	}
+	*field = value
+	return nil
+}
+
+// Sources a setting value can come from
+const (
+	SettingSourceDefault     = "default"
+	SettingSourceEnvironment = "environment"
+	SettingSourceFile        = "settings file"
+)
+
+// settingEnvVars lists the environment variables overriding each setting, in precedence order
+var settingEnvVars = map[string][]string{
+	SettingGitHubAPIURL: {EnvGitHubAPIURL},
+	SettingGitHubToken:  {EnvGitHubToken, "GITHUB_TOKEN"},
+	SettingMirrorURL:    {EnvMirrorURL},
+}
+
+// settingDefaults holds the values used when a setting is not configured
+var settingDefaults = map[string]string{
+	SettingGitHubAPIURL: DefaultGitHubAPIURL,
+	SettingMirrorURL:    DefaultMirrorURL,
+}
+
+// EffectiveSetting returns the value in effect for a setting key and where it comes from
+func EffectiveSetting(key string) (string, string, error) {
+	for _, env := range settingEnvVars[key] {
+		if value := os.Getenv(env); value != "" {
+			return value, SettingSourceEnvironment, nil
+		}
+	}
+
+	settings, err := LoadSettings()
+	if err != nil {
+		return settingDefaults[key], SettingSourceDefault, err
+	}
+	value, err := settings.Get(key)
+	if err != nil {
+		return "", "", err
+	}
+	if value != "" {
+		return value, SettingSourceFile, nil
+	}
+	return settingDefaults[key], SettingSourceDefault, nil
+}
+
+// GitHubAPIBaseURL returns the GitHub API base URL used for release data
+func GitHubAPIBaseURL() string {
+	url, _, _ := EffectiveSetting(SettingGitHubAPIURL)
+	return strings.TrimRight(url, "/")
+}
+
+// GitHubToken returns the token used to authenticate GitHub API requests, if any
+func GitHubToken() string {
+	token, _, _ := EffectiveSetting(SettingGitHubToken)
+	return token
+}
+
+// MirrorBaseURL returns the base URL JFrog CLI binaries are downloaded from. It follows the
+// releases.jfrog.io layout: <base>/v2-jf/<version>/jfrog-cli-<platform>/jf
+func MirrorBaseURL() string {
+	url, _, _ := EffectiveSetting(SettingMirrorURL)
+	return strings.TrimRight(url, "/")
+}
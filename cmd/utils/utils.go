@@ -21,6 +21,9 @@ const (
 	AliasesDir           = "aliases"
 	ShimDir              = "shim"
 	BlockFile            = "blocked-versions"
+	BenchmarksDir        = "benchmarks"
+	LocksDir             = "locks"
+	PlatformsDir         = "platforms"
 	MaxDescriptionLength = 40
 )
 
@@ -32,6 +35,9 @@ var (
 	JFCMAliases   = filepath.Join(JFCMRoot, AliasesDir)
 	JFCMShim      = filepath.Join(JFCMRoot, ShimDir)
 	JFCMBlockFile = filepath.Join(JFCMRoot, BlockFile)
+
+	JFCMBenchmarks = filepath.Join(JFCMRoot, BenchmarksDir)
+	JFCMLocks      = filepath.Join(JFCMRoot, LocksDir)
 )
 
 // InitializejfcmDirectories creates the necessary jfcm directories if they don't exist
@@ -54,16 +60,25 @@ func InitializejfcmDirectories() error {
 
 func GetVersionFromProjectFile() (string, error) {
 	fmt.Println("Attempting to read .jfrog-version file...")
-	data, err := os.ReadFile(ProjectFile)
+	version, err := ReadProjectVersion()
 	if err != nil {
 		fmt.Printf("Failed to read .jfrog-version file: %v\n", err)
 		return "", err
 	}
-	version := strings.TrimSpace(string(data))
 	fmt.Printf(".jfrog-version content: %s\n", version)
 	return version, nil
 }
 
+// ReadProjectVersion returns the version or constraint in the .jfrog-version file of the current
+// directory without printing anything
+func ReadProjectVersion() (string, error) {
+	data, err := os.ReadFile(ProjectFile)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
 // AliasData represents an alias configuration
 type AliasData struct {
 	Version     string `json:"version"`
@@ -132,7 +147,7 @@ func CheckVersionExists(version string) error {
 // GetLatestVersion fetches the latest version from GitHub API
 func GetLatestVersion() (string, error) {
 	// Use GitHub API to get the latest release
-	url := "https://api.github.com/repos/jfrog/jfrog-cli/releases/latest"
+	url := GitHubAPIBaseURL() + "/repos/jfrog/jfrog-cli/releases/latest"
 
 	// Create HTTP client with proper headers
 	client := &http.Client{
@@ -149,7 +164,7 @@ func GetLatestVersion() (string, error) {
 	req.Header.Set("Accept", "application/vnd.github.v3+json")
 
 	// Add GitHub token if available (for CI environments)
-	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+	if token := GitHubToken(); token != "" {
 		req.Header.Set("Authorization", "token "+token)
 	}
 
@@ -275,8 +290,8 @@ func SetupShim() error {
 		shimContent = createUnixShim()
 	}
 
-	// Write shim script
-	if err := os.WriteFile(shimPath, []byte(shimContent), 0755); err != nil {
+	// Write shim script; running shims read it, so it is replaced rather than rewritten in place
+	if err := WriteFileAtomic(shimPath, []byte(shimContent), 0755); err != nil {
 		return fmt.Errorf("failed to write shim script: %w", err)
 	}
 
@@ -318,6 +333,10 @@ if [ ! -f "$BINARY_PATH" ]; then
     exit 1
 fi
 
+# Record the use for 'jfcm prune --unused-for'; rewriting the empty marker only updates its
+# modification time, so this needs neither jfcm nor a lock
+: > "$jfcm_ROOT/versions/$ACTIVE_VERSION/.last-used" 2>/dev/null
+
 # Check if this is an interactive command (stdin is a terminal)
 if [ -t 0 ]; then
     # Interactive mode - use exec to preserve stdin/stdout/stderr
@@ -411,6 +430,9 @@ if not exist "%BINARY_PATH%" (
     exit /b 1
 )
 
+REM Record the use for 'jfcm prune --unused-for' by rewriting the empty marker
+type nul > "%jfcm_ROOT%\versions\%ACTIVE_VERSION%\.last-used" 2>nul
+
 REM Record command execution in history
 set COMMAND=jf %*
 set START_TIME=%TIME%
@@ -435,12 +457,17 @@ const (
 
 // UpdatePATH updates the user's shell profile to include jfcm shim in PATH with highest priority
 func UpdatePATH() error {
+	return UpdatePATHTo(os.Stdout)
+}
+
+// UpdatePATHTo is UpdatePATH writing its progress to w
+func UpdatePATHTo(w io.Writer) error {
 	// First, clean up the old bin directory if it exists
 	oldBinDir := filepath.Join(JFCMRoot, "bin")
 	if _, err := os.Stat(oldBinDir); err == nil {
-		fmt.Printf("Removing old bin directory: %s\n", oldBinDir)
+		fmt.Fprintf(w, "Removing old bin directory: %s\n", oldBinDir)
 		if err := os.RemoveAll(oldBinDir); err != nil {
-			fmt.Printf("Warning: Failed to remove old bin directory: %v\n", err)
+			fmt.Fprintf(w, "Warning: Failed to remove old bin directory: %v\n", err)
 		}
 	}
 
@@ -466,7 +493,7 @@ export PATH="%s:$PATH"
 
 	// Check if the expected block is already present
 	if strings.Contains(profileContent, expectedBlock) {
-		fmt.Printf("✅ jfcm PATH already configured correctly in %s\n", primaryProfileFile)
+		fmt.Fprintf(w, "✅ jfcm PATH already configured correctly in %s\n", primaryProfileFile)
 		return nil
 	}
 
@@ -490,9 +517,9 @@ export PATH="%s:$PATH"
 		return fmt.Errorf("failed to write profile file: %w", err)
 	}
 
-	fmt.Printf("✅ Added jfcm shim to PATH with highest priority in %s\n", primaryProfileFile)
-	fmt.Printf("🔧 jfcm-managed jf will now take precedence over system installations\n")
-	fmt.Printf("📝 Please restart your terminal or run: source %s\n", primaryProfileFile)
+	fmt.Fprintf(w, "✅ Added jfcm shim to PATH with highest priority in %s\n", primaryProfileFile)
+	fmt.Fprintf(w, "🔧 jfcm-managed jf will now take precedence over system installations\n")
+	fmt.Fprintf(w, "📝 Please restart your terminal or run: source %s\n", primaryProfileFile)
 
 	return nil
 }
@@ -617,39 +644,28 @@ func CheckShimSetup() error {
 	return nil
 }
 
-// VerifyPriority checks if jfcm-managed jf has highest priority
+// VerifyPriority checks that jf resolves to the jfcm shim rather than another installation on PATH
 func VerifyPriority() error {
 	// Check if shim exists
 	if err := CheckShimSetup(); err != nil {
 		return fmt.Errorf("shim setup issue: %w", err)
 	}
 
-	// Check PATH order
-	path := os.Getenv("PATH")
-	pathDirs := strings.Split(path, string(os.PathListSeparator))
-
-	// Find jfcm shim in PATH
+	installations := FindJfInstallations(os.Getenv("PATH"), false)
 	shimIndex := -1
-	systemJfIndex := -1
-
-	for i, dir := range pathDirs {
-		if strings.Contains(dir, ".jfcm/shim") {
+	for i, installation := range installations {
+		if filepath.Dir(installation.Path) == filepath.Clean(JFCMShim) {
 			shimIndex = i
-		}
-		// Check for common system jf locations
-		if strings.Contains(dir, "/usr/local/bin") || strings.Contains(dir, "/opt/homebrew/bin") || strings.Contains(dir, "/usr/bin") {
-			if systemJfIndex == -1 {
-				systemJfIndex = i
-			}
+			break
 		}
 	}
 
 	if shimIndex == -1 {
 		return fmt.Errorf("jfcm shim not found in PATH")
 	}
-
-	if systemJfIndex != -1 && shimIndex > systemJfIndex {
-		return fmt.Errorf("jfcm shim is not first in PATH (index %d vs system index %d)", shimIndex, systemJfIndex)
+	if shimIndex > 0 {
+		winner := installations[0]
+		return fmt.Errorf("jf resolves to %s (%s) instead of the jfcm shim", winner.Path, winner.Origin)
 	}
 
 	return nil
@@ -664,7 +680,7 @@ func SwitchToVersion(version string) error {
 	}
 
 	// Write the version to config file
-	if err := os.WriteFile(JFCMConfig, []byte(version), 0644); err != nil {
+	if err := WriteFileAtomic(JFCMConfig, []byte(version), 0644); err != nil {
 		return fmt.Errorf("failed to write config file: %w", err)
 	}
 
@@ -772,7 +788,7 @@ func BlockVersion(version string) error {
 	blockedVersions = append(blockedVersions, version)
 
 	content := strings.Join(blockedVersions, "\n")
-	if err := os.WriteFile(JFCMBlockFile, []byte(content), 0644); err != nil {
+	if err := WriteFileAtomic(JFCMBlockFile, []byte(content), 0644); err != nil {
 		return fmt.Errorf("failed to write block file: %w", err)
 	}
 
@@ -804,7 +820,7 @@ func UnblockVersion(version string) error {
 
 	if len(newBlockedVersions) > 0 {
 		content := strings.Join(newBlockedVersions, "\n")
-		if err := os.WriteFile(JFCMBlockFile, []byte(content), 0644); err != nil {
+		if err := WriteFileAtomic(JFCMBlockFile, []byte(content), 0644); err != nil {
 			return fmt.Errorf("failed to write block file: %w", err)
 		}
 	} else {
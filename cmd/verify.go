@@ -0,0 +1,305 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/descriptions"
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+	"github.com/jfrog/jfrog-cli-manager/internal"
+	"github.com/urfave/cli/v2"
+)
+
+// Outcomes of verifying an installed version
+const (
+	VerifyOK         = "ok"
+	VerifyBackfilled = "backfilled"
+	VerifyCorrupted  = "corrupted"
+)
+
+var Verify = &cli.Command{
+	Name:        "verify",
+	Usage:       descriptions.Verify.Usage,
+	ArgsUsage:   "[versions or aliases...]",
+	Description: descriptions.Verify.Format(),
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "reinstall",
+			Usage: "Download corrupted versions again",
+		},
+		&cli.BoolFlag{
+			Name:  "json",
+			Usage: "Output results in JSON format",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		var versions []string
+		for _, name := range c.Args().Slice() {
+			// Aliases verify the version they point to
+			version, err := utils.ResolveVersionOrAlias(name)
+			if err != nil {
+				version = name
+			}
+			if !slices.Contains(versions, version) {
+				versions = append(versions, version)
+			}
+		}
+		if len(versions) == 0 {
+			var err error
+			if versions, err = installedVersionDirs(); err != nil {
+				return cli.Exit(fmt.Sprintf("❌ Failed to read %s: %v", utils.JFCMVersions, err), 1)
+			}
+		}
+		if len(versions) == 0 {
+			fmt.Println("No versions installed.")
+			return nil
+		}
+
+		results := make([]VerifyResult, 0, len(versions))
+		for _, version := range versions {
+			results = append(results, verifyVersion(version))
+		}
+
+		if c.Bool("reinstall") {
+			for i, result := range results {
+				if result.Status != VerifyCorrupted {
+					continue
+				}
+				if err := reinstallVersion(result.Version, os.Stderr); err != nil {
+					results[i].Problems = append(results[i].Problems, err.Error())
+					continue
+				}
+				results[i] = verifyVersion(result.Version)
+			}
+		}
+
+		if c.Bool("json") {
+			printJSON(results)
+		} else {
+			displayVerifyResults(results)
+		}
+
+		if corrupted := countCorrupted(results); corrupted > 0 {
+			return cli.Exit(fmt.Sprintf("❌ %d of %d installed versions failed verification", corrupted, len(results)), 1)
+		}
+		return nil
+	},
+}
+
+// VerifyResult is the integrity audit of one installed version
+type VerifyResult struct {
+	Version         string   `json:"version"`
+	Status          string   `json:"status"`
+	SHA256          string   `json:"sha256,omitempty"`
+	Size            int64    `json:"size"`
+	ReportedVersion string   `json:"reported_version,omitempty"`
+	Problems        []string `json:"problems,omitempty"`
+}
+
+// installedVersionDirs lists every version directory, including those whose binary is missing
+func installedVersionDirs() ([]string, error) {
+	return versionDirsIn(utils.JFCMVersions)
+}
+
+// versionDirsIn returns the version directories under versionsDir, oldest version first
+func versionDirsIn(versionsDir string) ([]string, error) {
+	entries, err := os.ReadDir(versionsDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			versions = append(versions, entry.Name())
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return compareVersionNames(versions[i], versions[j]) < 0
+	})
+	return versions, nil
+}
+
+// compareVersionNames orders semantic versions numerically and other names alphabetically
+func compareVersionNames(a, b string) int {
+	va, errA := utils.ParseVersion(a)
+	vb, errB := utils.ParseVersion(b)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+	return va.Compare(vb)
+}
+
+// verifyVersion re-hashes the binary of a version against the recorded digest, checks that it
+// is executable and that `--version` reports the version named by its directory
+func verifyVersion(version string) VerifyResult {
+	result := VerifyResult{Version: version, Status: VerifyOK}
+	problem := func(format string, args ...interface{}) {
+		result.Status = VerifyCorrupted
+		result.Problems = append(result.Problems, fmt.Sprintf(format, args...))
+	}
+
+	binPath := filepath.Join(utils.JFCMVersions, version, utils.BinaryName)
+	info, err := os.Stat(binPath)
+	if err != nil {
+		problem("binary is missing")
+		return result
+	}
+	if info.Size() == 0 {
+		problem("binary is empty")
+		return result
+	}
+
+	digest, size, err := utils.HashFile(binPath)
+	if err != nil {
+		problem("binary cannot be read: %v", err)
+		return result
+	}
+	result.SHA256, result.Size = digest, size
+
+	meta, backfilled, err := utils.EnsureVersionMeta(version)
+	switch {
+	case backfilled:
+		result.Status = VerifyBackfilled
+	case err != nil:
+		problem("%v", err)
+	case size < meta.Size:
+		problem("binary is truncated (%d of %d bytes)", size, meta.Size)
+	case digest != meta.SHA256:
+		problem("binary was modified (sha256 %s, recorded %s)", shortDigest(digest), shortDigest(meta.SHA256))
+	}
+
+	if runtime.GOOS != "windows" && info.Mode()&0111 == 0 {
+		problem("binary is not executable")
+		return result
+	}
+
+	reported, err := utils.JfBinaryVersion(binPath)
+	if err != nil {
+		problem("binary does not run: %v", err)
+		return result
+	}
+	result.ReportedVersion = reported
+	// Linked versions may have arbitrary names; only semantic version directories are compared
+	if expected, err := utils.ParseVersion(version); err == nil {
+		if actual, err := utils.ParseVersion(reported); err != nil || actual.Compare(expected) != 0 {
+			problem("binary reports version %s", reported)
+		}
+	}
+	return result
+}
+
+// shortDigest abbreviates a hex digest for display
+func shortDigest(digest string) string {
+	if len(digest) > 12 {
+		return digest[:12]
+	}
+	return digest
+}
+
+// reinstallVersion downloads a version again, writing progress to out; only released versions can be reinstalled
+func reinstallVersion(version string, out io.Writer) error {
+	if _, err := utils.ParseVersion(version); err != nil {
+		return fmt.Errorf("%s is a linked version and cannot be reinstalled; link it again", version)
+	}
+	fmt.Fprintf(out, "🔄 Reinstalling %s...\n", version)
+	ctx, cancel := context.WithTimeout(context.Background(), internal.DownloadTimeout)
+	defer cancel()
+	downloader := internal.NewDownloader(os.Stderr)
+	downloader.Output = out
+	if err := internal.DownloadAndInstallContext(ctx, version, downloader); err != nil {
+		return fmt.Errorf("reinstall failed: %w", err)
+	}
+	return nil
+}
+
+// countCorrupted counts versions that failed verification
+func countCorrupted(results []VerifyResult) int {
+	count := 0
+	for _, result := range results {
+		if result.Status == VerifyCorrupted {
+			count++
+		}
+	}
+	return count
+}
+
+func displayVerifyResults(results []VerifyResult) {
+	fmt.Println("🔐 Verifying installed versions")
+	for _, result := range results {
+		switch result.Status {
+		case VerifyOK:
+			fmt.Printf("  ✅ %s: sha256 %s matches, runs as %s\n", result.Version, shortDigest(result.SHA256), result.ReportedVersion)
+		case VerifyBackfilled:
+			fmt.Printf("  ⚠️  %s: no digest recorded at install time; recorded sha256 %s for future checks\n", result.Version, shortDigest(result.SHA256))
+		default:
+			fmt.Printf("  ❌ %s: %s\n", result.Version, strings.Join(result.Problems, "; "))
+		}
+	}
+	if countCorrupted(results) > 0 {
+		fmt.Println("💡 Run 'jfcm verify --reinstall' to download corrupted versions again")
+	}
+}
+
+// checkBinaryIntegrity is the health-check form of jfcm verify
+func checkBinaryIntegrity(opts CheckOptions) []HealthStatus {
+	var results []HealthStatus
+
+	versions, err := installedVersionDirs()
+	if err != nil {
+		return append(results, HealthStatus{
+			Component: "Binary Integrity",
+			Status:    "warn",
+			Message:   "Cannot read versions directory",
+			Details:   err.Error(),
+		})
+	}
+
+	for _, version := range versions {
+		result := verifyVersion(version)
+		status := HealthStatus{Component: "Version " + version}
+		switch result.Status {
+		case VerifyOK:
+			status.Status = "pass"
+			status.Message = "Binary matches its recorded digest"
+			if opts.Verbose {
+				status.Details = "sha256 " + result.SHA256
+			}
+		case VerifyBackfilled:
+			status.Status = "warn"
+			status.Message = "No digest recorded at install time; recorded the current one"
+			status.Details = "sha256 " + result.SHA256
+		default:
+			status.Status = "fail"
+			status.Message = "Binary failed verification"
+			status.Details = strings.Join(result.Problems, "; ")
+			status.Fixable = true
+		}
+		results = append(results, status)
+	}
+	return results
+}
+
+func fixBinaryIntegrity(status HealthStatus, out io.Writer) error {
+	version, ok := strings.CutPrefix(status.Component, "Version ")
+	if !ok {
+		return errNoAutomaticFix
+	}
+	if err := reinstallVersion(version, out); err != nil {
+		return err
+	}
+	if result := verifyVersion(version); result.Status == VerifyCorrupted {
+		return fmt.Errorf("reinstalled binary still fails verification: %s", strings.Join(result.Problems, "; "))
+	}
+	return nil
+}
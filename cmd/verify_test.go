@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
+)
+
+// installFakeVersion writes a jf script reporting reported into the version directory
+func installFakeVersion(t *testing.T, version, reported string) string {
+	t.Helper()
+	dir := filepath.Join(utils.JFCMVersions, version)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	binPath := filepath.Join(dir, utils.BinaryName)
+	script := "#!/bin/sh\necho \"jf version " + reported + "\"\n"
+	if err := os.WriteFile(binPath, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	// WriteFile keeps the mode of an existing file
+	if err := os.Chmod(binPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	return binPath
+}
+
+func TestVerifyVersion(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses shell scripts as fake jf binaries")
+	}
+	oldVersions := utils.JFCMVersions
+	utils.JFCMVersions = t.TempDir()
+	defer func() { utils.JFCMVersions = oldVersions }()
+
+	installFakeVersion(t, "2.50.0", "2.50.0")
+	if result := verifyVersion("2.50.0"); result.Status != VerifyBackfilled || result.ReportedVersion != "2.50.0" {
+		t.Fatalf("expected the digest to be backfilled, got %+v", result)
+	}
+	if result := verifyVersion("2.50.0"); result.Status != VerifyOK {
+		t.Fatalf("expected a verified version, got %+v", result)
+	}
+
+	cases := map[string]func(binPath string){
+		"modified": func(binPath string) {
+			os.WriteFile(binPath, []byte("#!/bin/sh\necho \"jf version 2.50.0\" # patched\n"), 0755)
+		},
+		"truncated": func(binPath string) {
+			os.Truncate(binPath, 10)
+		},
+		"not executable": func(binPath string) {
+			os.Chmod(binPath, 0644)
+		},
+		"reports version": func(binPath string) {
+			installFakeVersion(t, "2.50.0", "2.49.9")
+		},
+		"missing": func(binPath string) {
+			os.Remove(binPath)
+		},
+	}
+	for expected, corrupt := range cases {
+		binPath := installFakeVersion(t, "2.50.0", "2.50.0")
+		if _, err := utils.WriteVersionMeta("2.50.0", utils.VersionMeta{Installer: utils.InstallerDownload}); err != nil {
+			t.Fatal(err)
+		}
+		corrupt(binPath)
+
+		result := verifyVersion("2.50.0")
+		if result.Status != VerifyCorrupted || !strings.Contains(strings.Join(result.Problems, "; "), expected) {
+			t.Errorf("%s: unexpected result %+v", expected, result)
+		}
+	}
+
+	// Linked versions are not compared with the version they report
+	installFakeVersion(t, "local-dev", "2.51.0")
+	if _, err := utils.WriteVersionMeta("local-dev", utils.VersionMeta{Installer: utils.InstallerLink}); err != nil {
+		t.Fatal(err)
+	}
+	if result := verifyVersion("local-dev"); result.Status != VerifyOK {
+		t.Errorf("expected the linked version to verify, got %+v", result)
+	}
+}
@@ -0,0 +1,334 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DownloadTimeout bounds a whole download, including retries
+	DownloadTimeout = 15 * time.Minute
+
+	// downloadStallTimeout aborts an attempt when no data arrives for this long
+	downloadStallTimeout = 60 * time.Second
+
+	defaultDownloadAttempts = 5
+	defaultInitialBackoff   = time.Second
+	defaultMaxBackoff       = 30 * time.Second
+)
+
+// errPermanent marks download failures that retrying cannot fix
+type errPermanent struct{ err error }
+
+func (e errPermanent) Error() string { return e.err.Error() }
+func (e errPermanent) Unwrap() error { return e.err }
+
+// ErrNotFound is returned when the server has no file at the URL
+var ErrNotFound = errors.New("not found")
+
+// Downloader fetches files over HTTP, resuming partial downloads with Range requests and
+// retrying transient failures with exponential backoff
+type Downloader struct {
+	Client         *http.Client
+	Attempts       int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	StallTimeout   time.Duration
+	// NewProgress creates the progress reporter of a download; nil reports nothing
+	NewProgress func(label string) ProgressReporter
+	// Output receives status messages such as the download URL; nil writes to stdout
+	Output io.Writer
+}
+
+// output returns the writer for status messages
+func (d *Downloader) output() io.Writer {
+	if d.Output == nil {
+		return os.Stdout
+	}
+	return d.Output
+}
+
+// NewDownloader returns a downloader reporting progress to w, as a bar when w is a terminal
+func NewDownloader(w *os.File) *Downloader {
+	return &Downloader{
+		Client: &http.Client{Transport: &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			ResponseHeaderTimeout: 30 * time.Second,
+			TLSHandshakeTimeout:   15 * time.Second,
+		}},
+		Attempts:       defaultDownloadAttempts,
+		InitialBackoff: defaultInitialBackoff,
+		MaxBackoff:     defaultMaxBackoff,
+		StallTimeout:   downloadStallTimeout,
+		NewProgress: func(label string) ProgressReporter {
+			return NewProgressReporter(w, label)
+		},
+	}
+}
+
+// Download fetches url into dest, reporting progress under label. Data is written to dest+".tmp",
+// which is kept between attempts (and between runs) so an interrupted download resumes where it stopped.
+// The ETag or Last-Modified of the response is kept next to it and sent as If-Range when resuming,
+// so a file that changed on the server is downloaded again from the start.
+func (d *Downloader) Download(ctx context.Context, label, url, dest string) error {
+	tmpPath := dest + ".tmp"
+	validatorPath := tmpPath + ".validator"
+	var progress ProgressReporter = noProgress{}
+	if d.NewProgress != nil {
+		progress = d.NewProgress(label)
+	}
+
+	attempts := d.Attempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	backoff := d.InitialBackoff
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		err = d.attempt(ctx, url, tmpPath, validatorPath, progress)
+		if err == nil {
+			progress.Done()
+			if err := os.Rename(tmpPath, dest); err != nil {
+				return fmt.Errorf("failed to move download to %s: %w", dest, err)
+			}
+			_ = os.Remove(validatorPath)
+			return nil
+		}
+
+		var permanent errPermanent
+		if errors.As(err, &permanent) || ctx.Err() != nil || attempt == attempts {
+			break
+		}
+		progress.Retry(attempt, attempts, backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return fmt.Errorf("download cancelled: %w", ctx.Err())
+		}
+		backoff *= 2
+		if d.MaxBackoff > 0 && backoff > d.MaxBackoff {
+			backoff = d.MaxBackoff
+		}
+	}
+
+	progress.Done()
+	if errors.Is(err, ErrNotFound) {
+		_ = os.Remove(tmpPath)
+		_ = os.Remove(validatorPath)
+	}
+	if ctx.Err() != nil {
+		return fmt.Errorf("download cancelled: %w", ctx.Err())
+	}
+	return err
+}
+
+// attempt performs one request, resuming from the size of tmpPath when validatorPath holds the
+// validator of the response the partial file came from
+func (d *Downloader) attempt(ctx context.Context, url, tmpPath, validatorPath string, progress ProgressReporter) error {
+	var offset int64
+	validator, _ := os.ReadFile(validatorPath)
+	if info, err := os.Stat(tmpPath); err == nil && len(validator) > 0 {
+		// Without a validator the partial file may belong to another build; start over
+		offset = info.Size()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errPermanent{err}
+	}
+	req.Header.Set("User-Agent", "jfcm/1.0")
+	if offset > 0 {
+		// If-Range makes the server send the whole file when it changed since the partial download
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		req.Header.Set("If-Range", string(validator))
+	}
+
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("http request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY
+	total := resp.ContentLength
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && offset > 0:
+		start, size, ok := parseContentRange(resp.Header.Get("Content-Range"))
+		if !ok || start != offset {
+			// Unusable range; discard the partial file and start over
+			_ = os.Remove(tmpPath)
+			return fmt.Errorf("server returned an unexpected range %q", resp.Header.Get("Content-Range"))
+		}
+		flags |= os.O_APPEND
+		total = size
+	case resp.StatusCode == http.StatusOK:
+		// The server ignored the range or the file changed; start from the beginning
+		flags |= os.O_TRUNC
+		offset = 0
+		if validator := responseValidator(resp); validator != "" {
+			if err := os.WriteFile(validatorPath, []byte(validator), 0644); err != nil {
+				return errPermanent{fmt.Errorf("failed to create temporary file: %w", err)}
+			}
+		} else {
+			_ = os.Remove(validatorPath)
+		}
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		if _, size, ok := parseContentRange(resp.Header.Get("Content-Range")); ok && size == offset {
+			// The partial file is already complete
+			return nil
+		}
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("partial download does not match the remote file")
+	case resp.StatusCode == http.StatusNotFound:
+		return errPermanent{ErrNotFound}
+	case isTransientStatus(resp.StatusCode):
+		return fmt.Errorf("server returned %s", resp.Status)
+	default:
+		return errPermanent{fmt.Errorf("failed to download: %s", resp.Status)}
+	}
+
+	out, err := os.OpenFile(tmpPath, flags, 0644)
+	if err != nil {
+		return errPermanent{fmt.Errorf("failed to create temporary file: %w", err)}
+	}
+
+	progress.Start(offset, total)
+	body := newStallReader(resp.Body, d.StallTimeout, cancel)
+	defer body.Stop()
+	_, copyErr := io.Copy(out, &progressReader{r: body, progress: progress, written: offset})
+	closeErr := out.Close()
+
+	if copyErr != nil {
+		if body.Stalled() {
+			return fmt.Errorf("no data received for %s", d.StallTimeout)
+		}
+		var netErr net.Error
+		if errors.As(copyErr, &netErr) || errors.Is(copyErr, io.ErrUnexpectedEOF) || ctx.Err() != nil {
+			return fmt.Errorf("download interrupted: %w", copyErr)
+		}
+		return fmt.Errorf("failed to write binary: %w", copyErr)
+	}
+	if closeErr != nil {
+		return errPermanent{fmt.Errorf("failed to write binary: %w", closeErr)}
+	}
+
+	if total > 0 {
+		if info, err := os.Stat(tmpPath); err == nil && info.Size() != total {
+			return fmt.Errorf("download incomplete: got %d of %d bytes", info.Size(), total)
+		}
+	}
+	return nil
+}
+
+// responseValidator returns the strong ETag of a response, or its Last-Modified date, for If-Range
+func responseValidator(resp *http.Response) string {
+	// Weak ETags cannot be used with If-Range
+	if etag := resp.Header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+		return etag
+	}
+	return resp.Header.Get("Last-Modified")
+}
+
+// isTransientStatus reports whether an HTTP status is worth retrying
+func isTransientStatus(code int) bool {
+	return code == http.StatusRequestTimeout || code == http.StatusTooManyRequests || code >= 500
+}
+
+// parseContentRange parses "bytes 100-199/200" or "bytes */200" into the start offset and total size
+func parseContentRange(value string) (start, size int64, ok bool) {
+	value, found := strings.CutPrefix(value, "bytes ")
+	if !found {
+		return 0, 0, false
+	}
+	rangePart, sizePart, found := strings.Cut(value, "/")
+	if !found {
+		return 0, 0, false
+	}
+	size, err := strconv.ParseInt(sizePart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	if rangePart == "*" {
+		return 0, size, true
+	}
+	startPart, _, found := strings.Cut(rangePart, "-")
+	if !found {
+		return 0, 0, false
+	}
+	start, err = strconv.ParseInt(startPart, 10, 64)
+	return start, size, err == nil
+}
+
+// stallReader cancels a request when reads stop returning data for longer than the timeout
+type stallReader struct {
+	r       io.Reader
+	timeout time.Duration
+	timer   *time.Timer
+	mu      sync.Mutex
+	stalled bool
+}
+
+func newStallReader(r io.Reader, timeout time.Duration, cancel context.CancelFunc) *stallReader {
+	s := &stallReader{r: r, timeout: timeout}
+	if timeout > 0 {
+		s.timer = time.AfterFunc(timeout, func() {
+			s.mu.Lock()
+			s.stalled = true
+			s.mu.Unlock()
+			cancel()
+		})
+	}
+	return s
+}
+
+func (s *stallReader) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	if n > 0 && s.timer != nil {
+		s.timer.Reset(s.timeout)
+	}
+	return n, err
+}
+
+// Stop releases the timer
+func (s *stallReader) Stop() {
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+}
+
+// Stalled reports whether the timeout fired
+func (s *stallReader) Stalled() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stalled
+}
+
+// progressReader reports the running byte count of a download
+type progressReader struct {
+	r        io.Reader
+	progress ProgressReporter
+	written  int64
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.written += int64(n)
+	p.progress.Update(p.written)
+	return n, err
+}
@@ -0,0 +1,173 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testDownloader returns a downloader that retries quickly and reports nothing
+func testDownloader(attempts int) *Downloader {
+	return &Downloader{Attempts: attempts, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+}
+
+func TestDownloadResumesPartialFile(t *testing.T) {
+	content := []byte("0123456789abcdefghij")
+	var gotRange, gotIfRange string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRange, gotIfRange = r.Header.Get("Range"), r.Header.Get("If-Range")
+		w.Header().Set("ETag", `"v2"`)
+		http.ServeContent(w, r, "jf", time.Time{}, bytes.NewReader(content))
+	}))
+	defer server.Close()
+
+	dest := filepath.Join(t.TempDir(), "jf")
+	if err := os.WriteFile(dest+".tmp", content[:8], 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(dest+".tmp.validator", []byte(`"v2"`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := testDownloader(1).Download(context.Background(), "jf", server.URL, dest); err != nil {
+		t.Fatal(err)
+	}
+
+	if gotRange != "bytes=8-" || gotIfRange != `"v2"` {
+		t.Errorf("expected a range request from byte 8 if the ETag is \"v2\", got %q if %q", gotRange, gotIfRange)
+	}
+	if got, _ := os.ReadFile(dest); !bytes.Equal(got, content) {
+		t.Errorf("expected %q, got %q", content, got)
+	}
+	for _, leftover := range []string{dest + ".tmp", dest + ".tmp.validator"} {
+		if _, err := os.Stat(leftover); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be removed", leftover)
+		}
+	}
+}
+
+func TestDownloadRestartsChangedPartialFile(t *testing.T) {
+	content := []byte("0123456789abcdefghij")
+	var ranges []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		w.Header().Set("ETag", `"v2"`)
+		http.ServeContent(w, r, "jf", time.Time{}, bytes.NewReader(content))
+	}))
+	defer server.Close()
+
+	for name, validator := range map[string]string{
+		"changed on the server": `"v1"`,
+		"without a validator":   "",
+	} {
+		t.Run(name, func(t *testing.T) {
+			ranges = nil
+			dest := filepath.Join(t.TempDir(), "jf")
+			if err := os.WriteFile(dest+".tmp", []byte("stale 01"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if validator != "" {
+				if err := os.WriteFile(dest+".tmp.validator", []byte(validator), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := testDownloader(1).Download(context.Background(), "jf", server.URL, dest); err != nil {
+				t.Fatal(err)
+			}
+			if got, _ := os.ReadFile(dest); !bytes.Equal(got, content) {
+				t.Errorf("expected the download to restart from zero, got %q", got)
+			}
+			if validator == "" && ranges[0] != "" {
+				t.Errorf("expected no range request without a validator, got %q", ranges[0])
+			}
+		})
+	}
+}
+
+func TestDownloadRetriesTransientErrors(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, "binary")
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	d := testDownloader(5)
+	d.NewProgress = func(label string) ProgressReporter { return newTextProgress(&out, label, time.Now) }
+
+	dest := filepath.Join(t.TempDir(), "jf")
+	if err := d.Download(context.Background(), "jf 2.50.0", server.URL, dest); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+	for _, expected := range []string{"jf 2.50.0: attempt 1/5 failed", "jf 2.50.0: attempt 2/5 failed", "📥 Downloading jf 2.50.0 (6 B)", "6 B received"} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("expected output to contain %q, got:\n%s", expected, out.String())
+		}
+	}
+}
+
+func TestDownloadNotFoundIsPermanent(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	dest := filepath.Join(t.TempDir(), "jf")
+	err := testDownloader(5).Download(context.Background(), "jf", server.URL, dest)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected no retries, got %d requests", requests)
+	}
+}
+
+func TestTextProgressInterval(t *testing.T) {
+	var out bytes.Buffer
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	p := newTextProgress(&out, "jf", func() time.Time { return now })
+
+	p.Start(0, 4096)
+	p.Update(1024)
+	now = now.Add(textProgressInterval)
+	p.Update(2048)
+	now = now.Add(time.Second)
+	p.Update(3072)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[1], "50% (2.0 KB of 4.0 KB") {
+		t.Errorf("expected one progress line per interval, got:\n%s", out.String())
+	}
+}
+
+func TestParseContentRange(t *testing.T) {
+	for value, expected := range map[string][2]int64{
+		"bytes 100-199/200": {100, 200},
+		"bytes */200":       {0, 200},
+	} {
+		start, size, ok := parseContentRange(value)
+		if !ok || start != expected[0] || size != expected[1] {
+			t.Errorf("%q: expected %v, got %d %d %v", value, expected, start, size, ok)
+		}
+	}
+	if _, _, ok := parseContentRange("items 1-2/3"); ok {
+		t.Errorf("expected a non-byte range to be rejected")
+	}
+}
@@ -1,9 +1,9 @@
 package internal
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -12,79 +12,76 @@ import (
 	"github.com/jfrog/jfrog-cli-manager/cmd/utils"
 )
 
-func mapPlatform(goos, arch string) (string, error) {
-	switch goos {
-	case "darwin":
-		if arch == "arm64" {
-			return "mac-arm64", nil
-		}
-		if arch == "amd64" {
-			return "mac-386", nil
-		}
-	case "linux":
-		if arch == "amd64" {
-			return "linux-amd64", nil
-		}
-	case "windows":
-		if arch == "amd64" {
-			return "windows-amd64", nil
-		}
-	}
-	return "", fmt.Errorf("unsupported platform: %s-%s", goos, arch)
+// DownloadAndInstall downloads a version from the mirror into the versions directory
+func DownloadAndInstall(version string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), DownloadTimeout)
+	defer cancel()
+	return DownloadAndInstallContext(ctx, version, NewDownloader(os.Stderr))
 }
 
-func DownloadAndInstall(version string) error {
-	platform, err := mapPlatform(runtime.GOOS, runtime.GOARCH)
+// DownloadAndInstallContext downloads a version with the given downloader. An interrupted
+// download leaves a partial file behind that the next attempt resumes.
+func DownloadAndInstallContext(ctx context.Context, version string, d *Downloader) error {
+	platform, err := LookupPlatform(runtime.GOOS, runtime.GOARCH)
 	if err != nil {
 		return err
 	}
+	return DownloadAndInstallPlatform(ctx, version, platform, d)
+}
 
-	url := fmt.Sprintf("https://releases.jfrog.io/artifactory/jfrog-cli/v2-jf/%s/jfrog-cli-%s/jf", version, platform)
-	fmt.Printf("📥 Downloading from: %s\n", url)
-
-	dir := filepath.Join(utils.JFCMVersions, version)
-	os.MkdirAll(dir, 0755)
+// DownloadAndInstallPlatform downloads the build of a version for a platform into that platform's
+// versions directory. Builds for other platforms than the host go to a cache and are never activated.
+func DownloadAndInstallPlatform(ctx context.Context, version string, platform Platform, d *Downloader) error {
+	versionsDir := utils.PlatformVersionsDir(platform.Name())
+	dir := filepath.Join(versionsDir, version)
 	binPath := filepath.Join(dir, utils.BinaryName)
+	host := platform.Name() == utils.HostPlatform()
 
-	tmpPath := binPath + ".tmp"
-	out, err := os.Create(tmpPath)
+	// Concurrent installs of the same version would share the partial download
+	versionLock, waited, err := utils.LockVersion(ctx, platform.Name(), version)
 	if err != nil {
-		return fmt.Errorf("failed to create temporary file: %w", err)
+		return err
 	}
-	defer out.Close()
-	defer os.Remove(tmpPath)
-
-	resp, err := http.Get(url)
-	if err != nil {
-		return fmt.Errorf("http request failed: %w", err)
+	defer versionLock.Release()
+	if _, err := os.Stat(binPath); waited && err == nil {
+		fmt.Fprintf(d.output(), "✅ Version %s was installed by another jfcm process\n", version)
+		return nil
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		if resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("version %s not found. Please check if this version exists", version)
-		}
-		return fmt.Errorf("failed to download: %s", resp.Status)
-	}
+	url := platform.URL(utils.MirrorBaseURL(), version)
+	fmt.Fprintf(d.output(), "📥 Downloading from: %s\n", url)
 
-	_, err = io.Copy(out, resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to write binary: %w", err)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create version directory: %w", err)
 	}
 
-	out.Close()
-
-	if err := os.Rename(tmpPath, binPath); err != nil {
-		return fmt.Errorf("failed to move binary to final location: %w", err)
+	label := "jf " + version
+	if !host {
+		label += " for " + platform.Name()
+	}
+	if err := d.Download(ctx, label, url, binPath); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			_ = os.Remove(dir)
+			return fmt.Errorf("version %s not found. Please check if this version exists", version)
+		}
+		return err
 	}
 
 	if err := os.Chmod(binPath, 0755); err != nil {
 		return fmt.Errorf("chmod failed: %w", err)
 	}
 
-	if runtime.GOOS == "darwin" {
+	if runtime.GOOS == "darwin" && host {
 		_ = exec.Command("xattr", "-c", binPath).Run()
 	}
 
+	if _, err := utils.WriteVersionMetaIn(versionsDir, version, utils.VersionMeta{
+		SourceURL: url,
+		Platform:  platform.Name(),
+		Installer: utils.InstallerDownload,
+	}); err != nil {
+		return fmt.Errorf("failed to record install metadata: %w", err)
+	}
+
 	return nil
 }
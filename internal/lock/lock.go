@@ -0,0 +1,77 @@
+// Package lock provides cross-process advisory file locks. jfcm commands take them before
+// changing files under ~/.jfcm so concurrent invocations do not interleave their writes.
+package lock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// pollInterval is how often a busy lock is retried
+const pollInterval = 100 * time.Millisecond
+
+// errLocked is returned by tryLockFile when another process holds the lock
+var errLocked = errors.New("lock is held by another process")
+
+// Lock is an exclusive lock held on a lock file. Lock files are never deleted, since removing
+// a file another process is waiting on would let two processes hold "the" lock at once.
+type Lock struct {
+	file *os.File
+}
+
+// TryAcquire takes the lock at path without waiting; ok is false when another process holds it
+func TryAcquire(path string) (l *Lock, ok bool, err error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, false, fmt.Errorf("failed to create lock directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to open lock file: %w", err)
+	}
+	if err := tryLockFile(file); err != nil {
+		file.Close()
+		if errors.Is(err, errLocked) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to lock %s: %w", path, err)
+	}
+	return &Lock{file: file}, true, nil
+}
+
+// Acquire takes the lock at path, waiting until it is free or ctx is done. onWait, when not nil,
+// is called once if the lock is busy, so callers can tell the user why they are waiting.
+func Acquire(ctx context.Context, path string, onWait func()) (*Lock, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for waited := false; ; waited = true {
+		l, ok, err := TryAcquire(path)
+		if err != nil || ok {
+			return l, err
+		}
+		if !waited && onWait != nil {
+			onWait()
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for lock %s: %w", path, ctx.Err())
+		}
+	}
+}
+
+// Release unlocks and closes the lock file
+func (l *Lock) Release() error {
+	if l == nil {
+		return nil
+	}
+	unlockErr := unlockFile(l.file)
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+	return unlockErr
+}
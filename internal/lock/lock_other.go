@@ -0,0 +1,11 @@
+//go:build !unix && !windows
+
+package lock
+
+import "os"
+
+// Platforms without file locking run unguarded
+
+func tryLockFile(*os.File) error { return nil }
+
+func unlockFile(*os.File) error { return nil }
@@ -0,0 +1,67 @@
+package lock
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTryAcquireIsExclusive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "locks", "state.lock")
+
+	first, ok, err := TryAcquire(path)
+	if err != nil || !ok {
+		t.Fatalf("expected the first lock to succeed, got ok=%v err=%v", ok, err)
+	}
+	if _, ok, err := TryAcquire(path); err != nil || ok {
+		t.Fatalf("expected the second lock to be refused, got ok=%v err=%v", ok, err)
+	}
+
+	if err := first.Release(); err != nil {
+		t.Fatal(err)
+	}
+	second, ok, err := TryAcquire(path)
+	if err != nil || !ok {
+		t.Fatalf("expected the lock to be free after release, got ok=%v err=%v", ok, err)
+	}
+	second.Release()
+}
+
+func TestAcquireWaitsForRelease(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.lock")
+	held, _, err := TryAcquire(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		time.Sleep(3 * pollInterval)
+		held.Release()
+	}()
+
+	waited := false
+	l, err := Acquire(context.Background(), path, func() { waited = true })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Release()
+	if !waited {
+		t.Errorf("expected onWait to be called while the lock was busy")
+	}
+}
+
+func TestAcquireTimesOut(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.lock")
+	held, _, err := TryAcquire(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer held.Release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*pollInterval)
+	defer cancel()
+	if _, err := Acquire(ctx, path, nil); err == nil {
+		t.Errorf("expected a timeout while the lock is held")
+	}
+}
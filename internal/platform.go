@@ -0,0 +1,86 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Platform maps a Go GOOS/GOARCH pair to the directory JFrog publishes its binaries under
+type Platform struct {
+	GOOS    string
+	GOARCH  string
+	Release string
+}
+
+// Platforms lists every platform JFrog publishes JFrog CLI binaries for
+var Platforms = []Platform{
+	{GOOS: "darwin", GOARCH: "amd64", Release: "mac-386"}, // historical name of the Intel build
+	{GOOS: "darwin", GOARCH: "arm64", Release: "mac-arm64"},
+	{GOOS: "linux", GOARCH: "386", Release: "linux-386"},
+	{GOOS: "linux", GOARCH: "amd64", Release: "linux-amd64"},
+	{GOOS: "linux", GOARCH: "arm", Release: "linux-arm"},
+	{GOOS: "linux", GOARCH: "arm64", Release: "linux-arm64"},
+	{GOOS: "linux", GOARCH: "ppc64", Release: "linux-ppc64"},
+	{GOOS: "linux", GOARCH: "ppc64le", Release: "linux-ppc64le"},
+	{GOOS: "linux", GOARCH: "s390x", Release: "linux-s390x"},
+	{GOOS: "windows", GOARCH: "amd64", Release: "windows-amd64"},
+}
+
+// Name returns the platform as GOOS-GOARCH, such as "linux-arm64"
+func (p Platform) Name() string {
+	return p.GOOS + "-" + p.GOARCH
+}
+
+// BinaryFile returns the file name of the published binary
+func (p Platform) BinaryFile() string {
+	if p.GOOS == "windows" {
+		return "jf.exe"
+	}
+	return "jf"
+}
+
+// URL returns the download URL of a version under a mirror with the releases.jfrog.io layout
+func (p Platform) URL(baseURL, version string) string {
+	return fmt.Sprintf("%s/v2-jf/%s/jfrog-cli-%s/%s", baseURL, version, p.Release, p.BinaryFile())
+}
+
+// LookupPlatform returns the published platform of a GOOS/GOARCH pair
+func LookupPlatform(goos, arch string) (Platform, error) {
+	for _, platform := range Platforms {
+		if platform.GOOS == goos && platform.GOARCH == arch {
+			return platform, nil
+		}
+	}
+	return Platform{}, fmt.Errorf("unsupported platform: %s-%s (supported: %s)", goos, arch, strings.Join(SupportedPlatforms(), ", "))
+}
+
+// LookupRelease returns the platform published under a release directory name such as "linux-arm64"
+func LookupRelease(release string) (Platform, error) {
+	for _, platform := range Platforms {
+		if platform.Release == release {
+			return platform, nil
+		}
+	}
+	return Platform{}, fmt.Errorf("unknown release platform: %s", release)
+}
+
+// ParsePlatform parses a platform written as "linux/arm64" or "linux-arm64"
+func ParsePlatform(value string) (Platform, error) {
+	goos, arch, found := strings.Cut(value, "/")
+	if !found {
+		goos, arch, found = strings.Cut(value, "-")
+	}
+	if !found || goos == "" || arch == "" {
+		return Platform{}, fmt.Errorf("invalid platform %q, expected os/arch such as linux/arm64 (supported: %s)", value, strings.Join(SupportedPlatforms(), ", "))
+	}
+	return LookupPlatform(goos, arch)
+}
+
+// SupportedPlatforms returns the names of all published platforms
+func SupportedPlatforms() []string {
+	names := make([]string, len(Platforms))
+	for i, platform := range Platforms {
+		names[i] = platform.Name()
+	}
+	return names
+}
@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLookupPlatform(t *testing.T) {
+	cases := []struct {
+		goos, arch string
+		release    string
+	}{
+		{"darwin", "amd64", "mac-386"},
+		{"darwin", "arm64", "mac-arm64"},
+		{"linux", "386", "linux-386"},
+		{"linux", "amd64", "linux-amd64"},
+		{"linux", "arm", "linux-arm"},
+		{"linux", "arm64", "linux-arm64"},
+		{"linux", "ppc64", "linux-ppc64"},
+		{"linux", "ppc64le", "linux-ppc64le"},
+		{"linux", "s390x", "linux-s390x"},
+		{"windows", "amd64", "windows-amd64"},
+	}
+	for _, tc := range cases {
+		platform, err := LookupPlatform(tc.goos, tc.arch)
+		if err != nil {
+			t.Errorf("%s-%s: %v", tc.goos, tc.arch, err)
+			continue
+		}
+		if platform.Release != tc.release {
+			t.Errorf("%s-%s: expected %s, got %s", tc.goos, tc.arch, tc.release, platform.Release)
+		}
+		if byRelease, err := LookupRelease(tc.release); err != nil || byRelease != platform {
+			t.Errorf("%s: expected %s, got %v (%v)", tc.release, platform.Name(), byRelease, err)
+		}
+	}
+	if len(cases) != len(Platforms) {
+		t.Errorf("expected every platform to be covered, got %d cases for %d platforms", len(cases), len(Platforms))
+	}
+}
+
+func TestLookupPlatformUnsupported(t *testing.T) {
+	_, err := LookupPlatform("linux", "mips")
+	if err == nil {
+		t.Fatal("expected an error for linux-mips")
+	}
+	for _, name := range []string{"linux-mips", "linux-arm64", "darwin-arm64", "windows-amd64"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("expected the error to mention %s, got %v", name, err)
+		}
+	}
+}
+
+func TestPlatformURL(t *testing.T) {
+	linux, _ := LookupPlatform("linux", "arm64")
+	if got, expected := linux.URL("https://mirror", "2.74.0"), "https://mirror/v2-jf/2.74.0/jfrog-cli-linux-arm64/jf"; got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+	windows, _ := LookupPlatform("windows", "amd64")
+	if got, expected := windows.URL("https://mirror", "2.74.0"), "https://mirror/v2-jf/2.74.0/jfrog-cli-windows-amd64/jf.exe"; got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
@@ -0,0 +1,220 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mattn/go-isatty"
+)
+
+const (
+	// barRefreshInterval limits how often the terminal progress bar is redrawn
+	barRefreshInterval = 100 * time.Millisecond
+
+	// textProgressInterval is how often plain-text progress is logged on non-terminals
+	textProgressInterval = 10 * time.Second
+
+	progressBarWidth = 30
+)
+
+// ProgressReporter receives the progress of a download
+type ProgressReporter interface {
+	// Start is called when data starts flowing; offset is the resumed size, total is -1 when unknown
+	Start(offset, total int64)
+	Update(written int64)
+	Retry(attempt, attempts int, backoff time.Duration, err error)
+	Done()
+}
+
+// noProgress discards progress
+type noProgress struct{}
+
+func (noProgress) Start(int64, int64)                   {}
+func (noProgress) Update(int64)                         {}
+func (noProgress) Retry(int, int, time.Duration, error) {}
+func (noProgress) Done()                                {}
+
+// NewProgressReporter returns a progress bar when w is a terminal, and periodic log lines otherwise
+func NewProgressReporter(w *os.File, label string) ProgressReporter {
+	if isatty.IsTerminal(w.Fd()) || isatty.IsCygwinTerminal(w.Fd()) {
+		return &barProgress{progressState: progressState{w: w, label: label, now: time.Now}}
+	}
+	return newTextProgress(w, label, time.Now)
+}
+
+// NewTextProgressReporter returns a reporter logging periodic lines, for downloads running side by side
+func NewTextProgressReporter(w io.Writer, label string) ProgressReporter {
+	return newTextProgress(w, label, time.Now)
+}
+
+// newTextProgress returns a plain-text reporter using now as its clock
+func newTextProgress(w io.Writer, label string, now func() time.Time) *textProgress {
+	return &textProgress{progressState: progressState{w: w, label: label, now: now}, interval: textProgressInterval}
+}
+
+// progressState tracks the numbers shared by both reporters
+type progressState struct {
+	mu       sync.Mutex
+	w        io.Writer
+	label    string
+	now      func() time.Time
+	started  time.Time
+	offset   int64 // bytes already present when the current attempt started
+	total    int64
+	written  int64
+	finished bool
+}
+
+func (p *progressState) start(offset, total int64) {
+	if p.started.IsZero() {
+		p.started = p.now()
+	}
+	p.offset, p.total, p.written = offset, total, offset
+}
+
+// rate returns the transfer rate of the bytes received in this run, in bytes per second
+func (p *progressState) rate() float64 {
+	elapsed := p.now().Sub(p.started).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(p.written-p.offset) / elapsed
+}
+
+// amount formats "40.1 MB of 90.2 MB" or "40.1 MB" when the total is unknown
+func (p *progressState) amount() string {
+	if p.total > 0 {
+		return fmt.Sprintf("%s of %s", formatBytes(p.written), formatBytes(p.total))
+	}
+	return formatBytes(p.written)
+}
+
+// percent returns the completed percentage, or -1 when the total is unknown
+func (p *progressState) percent() int {
+	if p.total <= 0 {
+		return -1
+	}
+	return int(p.written * 100 / p.total)
+}
+
+// barProgress redraws a single progress line on a terminal
+type barProgress struct {
+	progressState
+	lastDraw time.Time
+}
+
+func (p *barProgress) Start(offset, total int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.start(offset, total)
+	p.draw()
+}
+
+func (p *barProgress) Update(written int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.written = written
+	if p.now().Sub(p.lastDraw) >= barRefreshInterval {
+		p.draw()
+	}
+}
+
+func (p *barProgress) Retry(attempt, attempts int, backoff time.Duration, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fmt.Fprintf(p.w, "\r\033[K⚠️  %s: attempt %d/%d failed: %v; retrying in %s\n", p.label, attempt, attempts, err, backoff)
+}
+
+func (p *barProgress) Done() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.finished || p.started.IsZero() {
+		return
+	}
+	p.finished = true
+	p.draw()
+	fmt.Fprintln(p.w)
+}
+
+func (p *barProgress) draw() {
+	p.lastDraw = p.now()
+	bar := ""
+	if percent := p.percent(); percent >= 0 {
+		filled := percent * progressBarWidth / 100
+		bar = fmt.Sprintf("[%s%s] %3d%% ", strings.Repeat("█", filled), strings.Repeat("░", progressBarWidth-filled), percent)
+	}
+	fmt.Fprintf(p.w, "\r\033[K📥 %s %s%s %s/s", p.label, bar, p.amount(), formatBytes(int64(p.rate())))
+}
+
+// textProgress logs a progress line at a fixed interval, for CI logs
+type textProgress struct {
+	progressState
+	interval time.Duration
+	lastLog  time.Time
+}
+
+func (p *textProgress) Start(offset, total int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.start(offset, total)
+	p.lastLog = p.now()
+
+	size := "unknown size"
+	if total > 0 {
+		size = formatBytes(total)
+	}
+	if offset > 0 {
+		fmt.Fprintf(p.w, "📥 Resuming %s at %s (%s)\n", p.label, formatBytes(offset), size)
+	} else {
+		fmt.Fprintf(p.w, "📥 Downloading %s (%s)\n", p.label, size)
+	}
+}
+
+func (p *textProgress) Update(written int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.written = written
+	if p.now().Sub(p.lastLog) < p.interval {
+		return
+	}
+	p.lastLog = p.now()
+	if percent := p.percent(); percent >= 0 {
+		fmt.Fprintf(p.w, "   %s: %d%% (%s, %s/s)\n", p.label, percent, p.amount(), formatBytes(int64(p.rate())))
+	} else {
+		fmt.Fprintf(p.w, "   %s: %s (%s/s)\n", p.label, p.amount(), formatBytes(int64(p.rate())))
+	}
+}
+
+func (p *textProgress) Retry(attempt, attempts int, backoff time.Duration, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fmt.Fprintf(p.w, "⚠️  %s: attempt %d/%d failed: %v; retrying in %s\n", p.label, attempt, attempts, err, backoff)
+}
+
+func (p *textProgress) Done() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.finished || p.started.IsZero() {
+		return
+	}
+	p.finished = true
+	fmt.Fprintf(p.w, "   %s: %s received in %s\n", p.label, formatBytes(p.written), p.now().Sub(p.started).Round(time.Second))
+}
+
+// formatBytes formats a byte count with binary units
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
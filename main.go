@@ -57,6 +57,13 @@ func main() {
 			cmd.Block,
 			cmd.Unblock,
 			cmd.ListBlocked,
+			cmd.Settings,
+			cmd.UpgradeAdvisor,
+			cmd.Verify,
+			cmd.Prune,
+			cmd.Export,
+			cmd.Bundle,
+			cmd.Serve,
 		},
 	}
 
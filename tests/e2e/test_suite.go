@@ -209,7 +209,7 @@ func TestCoreVersionManagement(t *testing.T) {
 		// First install a version to clear
 		ts.RunCommand(t, "install", "2.74.0")
 
-		output, err := ts.RunCommand(t, "clear")
+		output, err := ts.RunCommand(t, "clear", "--force")
 		ts.AssertSuccess(t, output, err)
 
 		// Verify it's cleared
@@ -496,7 +496,7 @@ func TestIntegration(t *testing.T) {
 		ts.RunCommand(t, "history")
 
 		// Clean up
-		ts.RunCommand(t, "clear")
+		ts.RunCommand(t, "clear", "--force")
 	})
 }
 
@@ -701,8 +701,9 @@ func TestRTCompareFunctionality(t *testing.T) {
 		// Test RT compare command shows proper help
 		output, err := ts.RunCommand(t, "compare", "rt", "--help")
 		ts.AssertSuccess(t, output, err)
-		ts.AssertContains(t, output, "Compare JFrog CLI command execution between two servers")
-		ts.AssertContains(t, output, "<server1> <server2> -- <jf-command>")
+		ts.AssertContains(t, output, "Compare JFrog CLI command execution between two or more servers")
+		ts.AssertContains(t, output, "<server1>[@version] <server2>[@version] [server...] -- <jf-command>")
+		ts.AssertContains(t, output, "--max-parallel")
 		ts.AssertContains(t, output, "--unified")
 		ts.AssertContains(t, output, "--timeout")
 	})
@@ -724,6 +725,13 @@ func TestRTCompareFunctionality(t *testing.T) {
 		ts.AssertContains(t, output, "no command specified after '--'")
 	})
 
+	t.Run("RT Compare Invalid Server Target", func(t *testing.T) {
+		// Test a server target with an empty version
+		output, err := ts.RunCommand(t, "compare", "rt", "server1@", "server2", "--", "rt", "ping")
+		ts.AssertFailure(t, output, err)
+		ts.AssertContains(t, output, "missing version after '@'")
+	})
+
 	t.Run("RT Compare Invalid Separator Position", func(t *testing.T) {
 		// Test separator in wrong position
 		output, err := ts.RunCommand(t, "compare", "rt", "server1", "--", "server2", "rt", "ping")